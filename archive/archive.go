@@ -0,0 +1,65 @@
+package archive
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Archive represents a store of completed games.
+type Archive interface {
+
+	// Add stores the specified record. If the record has no ID, a new unique
+	// ID is assigned to it. Adding a record with the ID of an existing record
+	// replaces it.
+	Add(r *Record) error
+
+	// All returns all the records in the archive, in no particular order.
+	All() ([]Record, error)
+
+	// Get returns the record with the specified ID, or a RecordNotFoundError
+	// if there is no such record.
+	Get(id string) (Record, error)
+}
+
+// Find returns the records in the specified archive which match all of the
+// given filters, ordered from oldest to newest (and then by ID).
+func Find(a Archive, filters ...Filter) ([]Record, error) {
+	all, err := a.All()
+	if err != nil {
+		return nil, err
+	}
+
+	var found []Record
+
+nextRecord:
+	for i := range all {
+		for _, f := range filters {
+			if !f(&all[i]) {
+				continue nextRecord
+			}
+		}
+		found = append(found, all[i])
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		if !found[i].Date.Equal(found[j].Date) {
+			return found[i].Date.Before(found[j].Date)
+		}
+		return found[i].ID < found[j].ID
+	})
+
+	return found, nil
+}
+
+// newID returns an ID for the specified record which is unique according to
+// the given function for checking whether an ID is already taken. IDs are
+// derived from the date of the game, so that they sort chronologically.
+func newID(r *Record, taken func(id string) bool) string {
+	base := r.Date.UTC().Format("20060102-150405")
+
+	id := base
+	for n := 2; taken(id); n++ {
+		id = fmt.Sprintf("%s-%d", base, n)
+	}
+	return id
+}
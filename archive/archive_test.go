@@ -0,0 +1,133 @@
+package archive
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func testArchive(t *testing.T, a Archive) {
+	date := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run(".Add()", func(t *testing.T) {
+
+		t.Run("assigns unique IDs derived from the date", func(t *testing.T) {
+			r1 := Record{Date: date, Players: []string{"alice", "bob"}, Scores: []int{400, 300}}
+			r2 := Record{Date: date, Players: []string{"carol", "bob"}, Scores: []int{350, 360}}
+
+			if err := a.Add(&r1); err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if err := a.Add(&r2); err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+
+			if actual, expected := r1.ID, "20180601-120000"; actual != expected {
+				t.Errorf("Expected ID '%s' but got '%s'", expected, actual)
+			}
+			if actual, expected := r2.ID, "20180601-120000-2"; actual != expected {
+				t.Errorf("Expected ID '%s' but got '%s'", expected, actual)
+			}
+		})
+
+		t.Run("replaces records with the same ID", func(t *testing.T) {
+			r := Record{ID: "20180601-120000-2", Date: date, Players: []string{"carol", "dave"}}
+
+			if err := a.Add(&r); err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+
+			all, _ := a.All()
+			if actual, expected := len(all), 2; actual != expected {
+				t.Errorf("Expected %d records but got %d", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".Get()", func(t *testing.T) {
+
+		t.Run("returns the record with the ID", func(t *testing.T) {
+			r, err := a.Get("20180601-120000")
+
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if actual, expected := r.Scores[1], 300; actual != expected {
+				t.Errorf("Expected score %d but got %d", expected, actual)
+			}
+		})
+
+		t.Run("returns an error for missing records", func(t *testing.T) {
+			_, err := a.Get("nonexistent")
+
+			if actual, expected := err, (RecordNotFoundError{ID: "nonexistent"}); actual != expected {
+				t.Errorf("Expected error %v but got %v", expected, actual)
+			}
+		})
+	})
+}
+
+func TestDirectory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatalf("Unexpected error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	a, err := OpenDirectory(dir)
+	if err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+
+	testArchive(t, a)
+
+	t.Run(".Add()", func(t *testing.T) {
+
+		t.Run("rejects IDs which aren't valid file names", func(t *testing.T) {
+			err := a.Add(&Record{ID: "../escape"})
+
+			if actual, expected := err, (InvalidRecordIDError{ID: "../escape"}); actual != expected {
+				t.Errorf("Expected error %v but got %v", expected, actual)
+			}
+		})
+	})
+}
+
+func TestFind(t *testing.T) {
+	a := NewMemory()
+	for _, r := range []Record{
+		{ID: "c", Date: time.Date(2018, 6, 3, 0, 0, 0, 0, time.UTC), Players: []string{"alice"}},
+		{ID: "a", Date: time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC), Players: []string{"alice"}},
+		{ID: "b", Date: time.Date(2018, 6, 2, 0, 0, 0, 0, time.UTC), Players: []string{"bob"}},
+	} {
+		r := r
+		a.Add(&r)
+	}
+
+	t.Run("returns matching records from oldest to newest", func(t *testing.T) {
+		found, err := Find(a, Player("alice"))
+
+		if err != nil {
+			t.Fatalf("Expected no error but got %v", err)
+		}
+		if actual, expected := len(found), 2; actual != expected {
+			t.Fatalf("Expected %d records but got %d", expected, actual)
+		}
+		if found[0].ID != "a" || found[1].ID != "c" {
+			t.Errorf("Expected records a and c but got %s and %s", found[0].ID, found[1].ID)
+		}
+	})
+
+	t.Run("returns all records with no filters", func(t *testing.T) {
+		found, _ := Find(a)
+
+		if actual, expected := len(found), 3; actual != expected {
+			t.Errorf("Expected %d records but got %d", expected, actual)
+		}
+	})
+}
+
+func TestMemory(t *testing.T) {
+	testArchive(t, NewMemory())
+}
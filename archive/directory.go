@@ -0,0 +1,125 @@
+package archive
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const recordFileExtension = ".json"
+
+// Directory is an Archive which keeps each record as a JSON file in a
+// directory on the file system. It is safe for concurrent use within a single
+// process.
+type Directory struct {
+	mutex sync.RWMutex
+	path  string
+}
+
+// OpenDirectory returns an archive backed by the directory at the specified
+// path, creating the directory if it doesn't exist.
+func OpenDirectory(path string) (*Directory, error) {
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, err
+	}
+	return &Directory{path: path}, nil
+}
+
+// Add writes the specified record to its file, assigning it a new ID if it
+// has none. Files are replaced atomically, so that they aren't left partially
+// written if writing fails.
+func (d *Directory) Add(r *Record) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if r.ID == "" {
+		r.ID = newID(r, func(id string) bool {
+			_, err := os.Stat(d.recordPath(id))
+			return err == nil
+		})
+	} else if !validID(r.ID) {
+		return InvalidRecordIDError{ID: r.ID}
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(d.path, r.ID+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), d.recordPath(r.ID))
+}
+
+// All reads and returns all the records in the archive.
+func (d *Directory) All() ([]Record, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	files, err := ioutil.ReadDir(d.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []Record
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), recordFileExtension) {
+			continue
+		}
+
+		r, err := d.read(strings.TrimSuffix(f.Name(), recordFileExtension))
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+
+	return records, nil
+}
+
+// Get reads and returns the record with the specified ID.
+func (d *Directory) Get(id string) (Record, error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.read(id)
+}
+
+func (d *Directory) read(id string) (r Record, err error) {
+	if !validID(id) {
+		return r, RecordNotFoundError{ID: id}
+	}
+
+	data, err := ioutil.ReadFile(d.recordPath(id))
+	if os.IsNotExist(err) {
+		return r, RecordNotFoundError{ID: id}
+	} else if err != nil {
+		return r, err
+	}
+
+	err = json.Unmarshal(data, &r)
+	return
+}
+
+func (d *Directory) recordPath(id string) string {
+	return filepath.Join(d.path, id+recordFileExtension)
+}
+
+func validID(id string) bool {
+	return id != "" && id != "." && id != ".." && !strings.ContainsAny(id, `/\`)
+}
@@ -0,0 +1,24 @@
+package archive
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Export writes the specified records to a writer as a JSON array, in the
+// same format as records are stored by a Directory archive.
+func Export(w io.Writer, records []Record) error {
+	if records == nil {
+		records = []Record{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(records)
+}
+
+// Import reads records previously written by Export.
+func Import(r io.Reader) (records []Record, err error) {
+	err = json.NewDecoder(r).Decode(&records)
+	return
+}
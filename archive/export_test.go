@@ -0,0 +1,48 @@
+package archive
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestExport(t *testing.T) {
+
+	t.Run("writes records which can be imported again", func(t *testing.T) {
+		r := RecordFromGame(setupGame(), []string{"alice", "bob"}, time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC))
+		r.ID = "game"
+		r.Rules = map[string]string{"board": "standard"}
+
+		var buf bytes.Buffer
+		if err := Export(&buf, []Record{r}); err != nil {
+			t.Fatalf("Expected no error but got %v", err)
+		}
+
+		imported, err := Import(&buf)
+		if err != nil {
+			t.Fatalf("Expected no error but got %v", err)
+		}
+
+		if actual, expected := len(imported), 1; actual != expected {
+			t.Fatalf("Expected %d records but got %d", expected, actual)
+		}
+		if actual, expected := imported[0].Rules["board"], "standard"; actual != expected {
+			t.Errorf("Expected board rule '%s' but got '%s'", expected, actual)
+		}
+		if actual, expected := imported[0].History[0].TilesPlayed[6], r.History[0].TilesPlayed[6]; actual != expected {
+			t.Errorf("Expected placement %v but got %v", expected, actual)
+		}
+		if actual, expected := imported[0].Words()[1], "ZA"; actual != expected {
+			t.Errorf("Expected word '%s' but got '%s'", expected, actual)
+		}
+	})
+
+	t.Run("writes an empty array for no records", func(t *testing.T) {
+		var buf bytes.Buffer
+		Export(&buf, nil)
+
+		if actual, expected := buf.String(), "[]\n"; actual != expected {
+			t.Errorf("Expected %q but got %q", expected, actual)
+		}
+	})
+}
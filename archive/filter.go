@@ -0,0 +1,4 @@
+package archive
+
+// Filter represents a condition which an archived game must meet.
+type Filter func(r *Record) (matches bool)
@@ -0,0 +1,56 @@
+package archive
+
+import (
+	"strings"
+	"time"
+)
+
+// Between returns a Filter which matches games played from the start time up
+// to but not including the end time. A zero start or end time leaves that end
+// of the range open.
+func Between(start, end time.Time) Filter {
+	return func(r *Record) bool {
+		return (start.IsZero() || !r.Date.Before(start)) && (end.IsZero() || r.Date.Before(end))
+	}
+}
+
+// MinBingos returns a Filter which matches games in which at least the
+// specified number of bingos were played, by all players together.
+func MinBingos(bingos int) Filter {
+	return func(r *Record) bool {
+		return r.TotalBingos() >= bingos
+	}
+}
+
+// MinScore returns a Filter which matches games in which any player finished
+// with at least the specified score.
+func MinScore(score int) Filter {
+	return func(r *Record) bool {
+		return len(r.Scores) > 0 && r.HighScore() >= score
+	}
+}
+
+// Player returns a Filter which matches games played by the named player.
+func Player(name string) Filter {
+	return func(r *Record) bool {
+		for _, p := range r.Players {
+			if p == name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Word returns a Filter which matches games in which the specified word was
+// played (and not withdrawn). Words are compared case insensitively.
+func Word(word string) Filter {
+	return func(r *Record) bool {
+		for _, w := range r.Words() {
+			if strings.EqualFold(w, word) {
+				return true
+			}
+		}
+		return false
+	}
+}
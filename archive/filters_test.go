@@ -0,0 +1,36 @@
+package archive
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFilters(t *testing.T) {
+	r := RecordFromGame(setupGame(), []string{"alice", "bob"}, time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	cases := []struct {
+		Description string
+		Filter      Filter
+		Expected    bool
+	}{
+		{"Between() matches dates in range", Between(time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2018, 6, 2, 0, 0, 0, 0, time.UTC)), true},
+		{"Between() excludes the end time", Between(time.Time{}, time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)), false},
+		{"Between() allows open ranges", Between(time.Time{}, time.Time{}), true},
+		{"MinBingos() matches enough bingos", MinBingos(1), true},
+		{"MinBingos() excludes too few bingos", MinBingos(2), false},
+		{"MinScore() matches high enough scores", MinScore(120), true},
+		{"MinScore() excludes lower scores", MinScore(121), false},
+		{"Player() matches players of the game", Player("bob"), true},
+		{"Player() excludes other players", Player("carol"), false},
+		{"Word() matches played words case insensitively", Word("retains"), true},
+		{"Word() excludes withdrawn words", Word("XI"), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Description, func(t *testing.T) {
+			if actual := c.Filter(&r); actual != c.Expected {
+				t.Errorf("Expected %v but got %v", c.Expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package archive
+
+import "fmt"
+
+// InvalidRecordIDError indicates that a record couldn't be stored because its
+// ID can't be used by the archive (for example, because it contains path
+// separators).
+type InvalidRecordIDError struct {
+	ID string
+}
+
+func (e InvalidRecordIDError) Error() string {
+	return fmt.Sprintf("%#v", e)
+}
@@ -0,0 +1,55 @@
+package archive
+
+import "sync"
+
+// Memory is an Archive which keeps records in memory. It is safe for
+// concurrent use.
+type Memory struct {
+	mutex   sync.RWMutex
+	records map[string]Record
+}
+
+// NewMemory returns a new empty in-memory archive.
+func NewMemory() *Memory {
+	return &Memory{records: make(map[string]Record)}
+}
+
+// Add stores the specified record, assigning it a new ID if it has none.
+func (m *Memory) Add(r *Record) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.ID == "" {
+		r.ID = newID(r, func(id string) bool {
+			_, taken := m.records[id]
+			return taken
+		})
+	}
+
+	m.records[r.ID] = *r
+	return nil
+}
+
+// All returns all the records in the archive.
+func (m *Memory) All() ([]Record, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	records := make([]Record, 0, len(m.records))
+	for _, r := range m.records {
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+// Get returns the record with the specified ID.
+func (m *Memory) Get(id string) (Record, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	r, ok := m.records[id]
+	if !ok {
+		return r, RecordNotFoundError{ID: id}
+	}
+	return r, nil
+}
@@ -0,0 +1,79 @@
+package archive
+
+import (
+	"time"
+
+	"github.com/mandykoh/scrubble/game"
+	"github.com/mandykoh/scrubble/history"
+	"github.com/mandykoh/scrubble/stats"
+)
+
+// Record represents a completed game kept in an archive.
+//
+// Since game.Rules are made up of behaviours which can't be stored, the rules
+// a game was played under are recorded as a set of named descriptions (for
+// example, "dictionary" and "board"), which are up to the caller to define.
+type Record struct {
+	ID      string            `json:"id"`
+	Date    time.Time         `json:"date"`
+	Rules   map[string]string `json:"rules,omitempty"`
+	Players []string          `json:"players"`
+	Scores  []int             `json:"scores"`
+	Bingos  []int             `json:"bingos"`
+	History history.History   `json:"history"`
+}
+
+// RecordFromGame returns a Record of the specified completed game, with the
+// given names for the players in each seat. The record has no ID until it is
+// added to an archive.
+func RecordFromGame(g *game.Game, players []string, date time.Time) Record {
+	r := Record{
+		Date:    date,
+		Players: players,
+		History: g.History,
+	}
+
+	for _, p := range stats.Compute(g).Players {
+		r.Scores = append(r.Scores, p.FinalScore)
+		r.Bingos = append(r.Bingos, p.Bingos)
+	}
+
+	return r
+}
+
+// HighScore returns the highest final score of any player in the game.
+func (r *Record) HighScore() (score int) {
+	for i, s := range r.Scores {
+		if i == 0 || s > score {
+			score = s
+		}
+	}
+	return
+}
+
+// TotalBingos returns the number of bingos played by all players in the game.
+func (r *Record) TotalBingos() (bingos int) {
+	for _, b := range r.Bingos {
+		bingos += b
+	}
+	return
+}
+
+// Words returns the words formed by all plays in the game, excluding plays
+// which were withdrawn after a successful challenge (see
+// history.History.Withdrawn).
+func (r *Record) Words() (words []string) {
+	for i, e := range r.History {
+		if e.Type != history.PlayEntryType {
+			continue
+		}
+		if r.History.Withdrawn(i) {
+			continue
+		}
+
+		for _, w := range e.WordsFormed {
+			words = append(words, w.Word)
+		}
+	}
+	return
+}
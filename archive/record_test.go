@@ -0,0 +1,99 @@
+package archive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/game"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/seat"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func setupGame() *game.Game {
+	g := &game.Game{
+		Board: board.WithStandardLayout(),
+		Seats: []seat.Seat{{Score: 120}, {Score: 40}},
+	}
+
+	bingo := play.Tiles{
+		{tile.Make('R', 1), coord.Make(7, 1)},
+		{tile.Make('E', 1), coord.Make(7, 2)},
+		{tile.Make('T', 1), coord.Make(7, 3)},
+		{tile.Make('A', 1), coord.Make(7, 4)},
+		{tile.Make('I', 1), coord.Make(7, 5)},
+		{tile.Make('N', 1), coord.Make(7, 6)},
+		{tile.Make('S', 1), coord.Make(7, 7)},
+	}
+
+	g.History.AppendPlay(0, 70, bingo.Tiles(), bingo, nil, []play.Word{{Word: "RETAINS", Score: 70}})
+	g.History.AppendPlay(1, 30, nil, play.Tiles{
+		{tile.Make('X', 8), coord.Make(6, 1)},
+		{tile.Make('I', 1), coord.Make(6, 2)},
+	}, nil, []play.Word{{Word: "XI", Score: 30}})
+	g.History.AppendChallengeSuccess(0)
+	g.History.AppendPlay(1, 40, nil, play.Tiles{
+		{tile.Make('Z', 10), coord.Make(8, 1)},
+		{tile.Make('A', 1), coord.Make(8, 2)},
+	}, nil, []play.Word{{Word: "ZA", Score: 40}})
+
+	return g
+}
+
+func TestRecordFromGame(t *testing.T) {
+	date := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+	r := RecordFromGame(setupGame(), []string{"alice", "bob"}, date)
+
+	t.Run("records players, final scores, and bingos", func(t *testing.T) {
+		if actual, expected := r.Players[1], "bob"; actual != expected {
+			t.Errorf("Expected player '%s' but got '%s'", expected, actual)
+		}
+		if actual, expected := r.Scores[0], 120; actual != expected {
+			t.Errorf("Expected score %d but got %d", expected, actual)
+		}
+		if actual, expected := r.Bingos[0], 1; actual != expected {
+			t.Errorf("Expected %d bingos but got %d", expected, actual)
+		}
+		if actual, expected := len(r.History), 4; actual != expected {
+			t.Errorf("Expected %d history entries but got %d", expected, actual)
+		}
+	})
+}
+
+func TestRecord(t *testing.T) {
+	r := RecordFromGame(setupGame(), []string{"alice", "bob"}, time.Now())
+
+	t.Run(".HighScore()", func(t *testing.T) {
+
+		t.Run("returns the highest final score", func(t *testing.T) {
+			if actual, expected := r.HighScore(), 120; actual != expected {
+				t.Errorf("Expected %d but got %d", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".TotalBingos()", func(t *testing.T) {
+
+		t.Run("returns bingos for all players", func(t *testing.T) {
+			if actual, expected := r.TotalBingos(), 1; actual != expected {
+				t.Errorf("Expected %d but got %d", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".Words()", func(t *testing.T) {
+
+		t.Run("excludes withdrawn plays", func(t *testing.T) {
+			words := r.Words()
+
+			if actual, expected := len(words), 2; actual != expected {
+				t.Fatalf("Expected %d words but got %d: %v", expected, actual, words)
+			}
+			if words[0] != "RETAINS" || words[1] != "ZA" {
+				t.Errorf("Expected RETAINS and ZA but got %v", words)
+			}
+		})
+	})
+}
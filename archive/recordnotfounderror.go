@@ -0,0 +1,13 @@
+package archive
+
+import "fmt"
+
+// RecordNotFoundError indicates that an archive contains no record with the
+// requested ID.
+type RecordNotFoundError struct {
+	ID string
+}
+
+func (e RecordNotFoundError) Error() string {
+	return fmt.Sprintf("%#v", e)
+}
@@ -1,12 +1,17 @@
 package board
 
 var (
-	normalInstance            = &normal{}
-	startInstance             = &start{}
-	doubleLetterScoreInstance = &doubleLetterScore{}
-	doubleWordScoreInstance   = &doubleWordScore{}
-	tripleLetterScoreInstance = &tripleLetterScore{}
-	tripleWordScoreInstance   = &tripleWordScore{}
+	normalInstance               = &normal{}
+	startInstance                = &start{}
+	doubleLetterScoreInstance    = &doubleLetterScore{}
+	doubleWordScoreInstance      = &doubleWordScore{}
+	tripleLetterScoreInstance    = &tripleLetterScore{}
+	tripleWordScoreInstance      = &tripleWordScore{}
+	quadrupleLetterScoreInstance = &quadrupleLetterScore{}
+	quadrupleWordScoreInstance   = &quadrupleWordScore{}
+	blockedInstance              = &blocked{}
+	planarInstance               = &planar{}
+	toroidalInstance             = &toroidal{}
 )
 
 // AllPositionTypes returns a set of built in position types which can be used
@@ -26,3 +31,42 @@ func AllPositionTypes() (__, st, dl, dw, tl, tw PositionType) {
 		tripleLetterScoreInstance,
 		tripleWordScoreInstance
 }
+
+// BlockedPositionType returns the built in position type for blocked (void)
+// positions. Blocked positions are part of the board's grid but can never hold
+// tiles; they are treated as out of bounds for tile placement and act as word
+// boundaries for scoring. This allows non-rectangular boards (crosses,
+// diamonds, etc) and boards with obstacles to be laid out.
+//
+// The same instance is always returned so it can be compared to other position
+// types.
+//
+// See WithShapedLayout for example usage.
+func BlockedPositionType() PositionType {
+	return blockedInstance
+}
+
+// QuadPositionTypes returns the built in position types for quadruple letter
+// score and quadruple word score positions, as used by larger boards such as
+// the super layout.
+//
+// The same instances of the position types are always returned so they can be
+// compared to each other.
+func QuadPositionTypes() (ql, qw PositionType) {
+	return quadrupleLetterScoreInstance, quadrupleWordScoreInstance
+}
+
+// PlanarTopology returns the built in topology for ordinary flat boards, where
+// coordinates beyond the edges of the board are out of bounds. This is the
+// topology used by boards which don't specify one.
+func PlanarTopology() Topology {
+	return planarInstance
+}
+
+// ToroidalTopology returns the built in topology for boards whose edges wrap
+// around, such that stepping off the right edge continues from the left edge,
+// and stepping off the bottom edge continues from the top. Words can thus be
+// formed across the edges of the board.
+func ToroidalTopology() Topology {
+	return toroidalInstance
+}
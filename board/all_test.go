@@ -27,3 +27,51 @@ func TestAllPositionTypes(t *testing.T) {
 		}
 	})
 }
+
+func TestBlockedPositionType(t *testing.T) {
+
+	t.Run("returns the blocked position type", func(t *testing.T) {
+		if actual, expected := BlockedPositionType(), blockedInstance; actual != expected {
+			t.Errorf("Expected '%s' position type but got '%s' instead", expected.Name(), actual.Name())
+		}
+	})
+
+	t.Run("returns a position type distinct from all other built in types", func(t *testing.T) {
+		__, st, dl, dw, tl, tw := AllPositionTypes()
+
+		for _, other := range []PositionType{__, st, dl, dw, tl, tw} {
+			if BlockedPositionType() == other {
+				t.Errorf("Expected blocked position type to be distinct from '%s'", other.Name())
+			}
+		}
+	})
+}
+
+func TestQuadPositionTypes(t *testing.T) {
+
+	ql, qw := QuadPositionTypes()
+
+	t.Run("returns the quadruple score position types", func(t *testing.T) {
+		if ql != quadrupleLetterScoreInstance {
+			t.Errorf("Expected '%s' position type but got '%s' instead", quadrupleLetterScoreInstance.Name(), ql.Name())
+		}
+		if qw != quadrupleWordScoreInstance {
+			t.Errorf("Expected '%s' position type but got '%s' instead", quadrupleWordScoreInstance.Name(), qw.Name())
+		}
+	})
+
+	t.Run("quadruples letter and word scores", func(t *testing.T) {
+		if actual, expected := ql.ModifyTileScore(3), 12; actual != expected {
+			t.Errorf("Expected tile score %d but got %d", expected, actual)
+		}
+		if actual, expected := ql.ModifyWordScore(3), 3; actual != expected {
+			t.Errorf("Expected word score %d but got %d", expected, actual)
+		}
+		if actual, expected := qw.ModifyTileScore(3), 3; actual != expected {
+			t.Errorf("Expected tile score %d but got %d", expected, actual)
+		}
+		if actual, expected := qw.ModifyWordScore(3), 12; actual != expected {
+			t.Errorf("Expected word score %d but got %d", expected, actual)
+		}
+	})
+}
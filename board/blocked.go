@@ -0,0 +1,20 @@
+package board
+
+type blocked struct {
+}
+
+func (p *blocked) CountsAsConnected() bool {
+	return false
+}
+
+func (p *blocked) ModifyTileScore(score int) int {
+	return score
+}
+
+func (p *blocked) ModifyWordScore(score int) int {
+	return score
+}
+
+func (p *blocked) Name() string {
+	return "Blocked"
+}
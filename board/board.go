@@ -6,16 +6,33 @@ import (
 
 // Board represents a game board, which is a grid of positions on which tiles
 // can be placed. The zero-value of a Board is a zero-sized board.
+//
+// The Topology of a board determines how its edges behave. If no topology is
+// specified, the board is planar (see PlanarTopology).
 type Board struct {
 	Rows      int
 	Columns   int
 	Positions []Position
+	Topology  Topology
 }
 
-// WithLayout creates a board with no tiles, with the specified layout.
+// WithLayout creates a board with no tiles, with the specified layout. Rows
+// shorter than the widest row are filled out with normal positions, so that the
+// board is always rectangular.
 func WithLayout(layout Layout) Board {
 	normal, _, _, _, _, _ := AllPositionTypes()
+	return withLayoutPaddedBy(layout, normal)
+}
+
+// WithShapedLayout creates a board with no tiles, with the specified layout.
+// Rows shorter than the widest row are filled out with blocked positions, so
+// that irregular board shapes can be specified without explicitly blocking out
+// every trailing position.
+func WithShapedLayout(layout Layout) Board {
+	return withLayoutPaddedBy(layout, blockedInstance)
+}
 
+func withLayoutPaddedBy(layout Layout, padding PositionType) Board {
 	rows := len(layout)
 	columns := layout.WidestRow()
 
@@ -32,36 +49,45 @@ func WithLayout(layout Layout) Board {
 			b.Position(coord.Make(row, col)).Type = posType
 		}
 
-		// Fill in any unspecified remainder of the row with Normal positions
+		// Fill in any unspecified remainder of the row with padding positions
 		for col := len(lRow); col < columns; col++ {
-			b.Position(coord.Make(row, col)).Type = normal
+			b.Position(coord.Make(row, col)).Type = padding
 		}
 	}
 
 	return b
 }
 
+// WithAppLayout returns an empty Board with the app variant layout.
+func WithAppLayout() Board {
+	return WithLayout(AppLayout())
+}
+
 // WithStandardLayout returns an empty Board with a standardised layout.
 func WithStandardLayout() Board {
-	__, st, dl, dw, tl, tw := AllPositionTypes()
-
-	return WithLayout(Layout{
-		{tw, __, __, dl, __, __, __, tw, __, __, __, dl, __, __, tw},
-		{__, dw, __, __, __, tl, __, __, __, tl, __, __, __, dw, __},
-		{__, __, dw, __, __, __, dl, __, dl, __, __, __, dw, __, __},
-		{dl, __, __, dw, __, __, __, dl, __, __, __, dw, __, __, dl},
-		{__, __, __, __, dw, __, __, __, __, __, dw, __, __, __, __},
-		{__, tl, __, __, __, tl, __, __, __, tl, __, __, __, tl, __},
-		{__, __, dl, __, __, __, dl, __, dl, __, __, __, dl, __, __},
-		{tw, __, __, dl, __, __, __, st, __, __, __, dl, __, __, tw},
-		{__, __, dl, __, __, __, dl, __, dl, __, __, __, dl, __, __},
-		{__, tl, __, __, __, tl, __, __, __, tl, __, __, __, tl, __},
-		{__, __, __, __, dw, __, __, __, __, __, dw, __, __, __, __},
-		{dl, __, __, dw, __, __, __, dl, __, __, __, dw, __, __, dl},
-		{__, __, dw, __, __, __, dl, __, dl, __, __, __, dw, __, __},
-		{__, dw, __, __, __, tl, __, __, __, tl, __, __, __, dw, __},
-		{tw, __, __, dl, __, __, __, tw, __, __, __, dl, __, __, tw},
-	})
+	return WithLayout(StandardLayout())
+}
+
+// WithSuperLayout returns an empty 21x21 Board with the super variant layout.
+func WithSuperLayout() Board {
+	return WithLayout(SuperLayout())
+}
+
+// IsEmpty returns true if there are no tiles on the board.
+func (b *Board) IsEmpty() bool {
+	for _, p := range b.Positions {
+		if p.Tile != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Locate maps the specified coordinate onto the board according to the board's
+// topology, returning the equivalent on-board coordinate and whether the
+// coordinate corresponds to a position on the board at all.
+func (b *Board) Locate(c coord.Coord) (located coord.Coord, onBoard bool) {
+	return b.topology().Locate(c, b.Rows, b.Columns)
 }
 
 // NeighbourHasTile returns true if any neighbouring position of the position at
@@ -77,8 +103,9 @@ func (b *Board) NeighbourHasTile(c coord.Coord) bool {
 }
 
 // Neighbours returns the cardinal neighbouring positions to the specified
-// coordinate. If a neighbour would be out of bounds, nil is returned in its
-// place. Neighbours are always returned in North, South, East, West order.
+// coordinate, according to the board's topology. If a neighbour would be out of
+// bounds, nil is returned in its place. Neighbours are always returned in
+// North, South, East, West order.
 func (b *Board) Neighbours(c coord.Coord) [4]*Position {
 	return [4]*Position{
 		b.Position(c.North()),
@@ -88,11 +115,29 @@ func (b *Board) Neighbours(c coord.Coord) [4]*Position {
 	}
 }
 
-// Position returns the board position related to the specified coordinate.
-// If the requested position is out of bounds, nil is returned.
+// Position returns the board position related to the specified coordinate,
+// according to the board's topology. If the requested position is out of
+// bounds, nil is returned.
 func (b *Board) Position(c coord.Coord) *Position {
-	if c.Row < 0 || c.Row >= b.Rows || c.Column < 0 || c.Column >= b.Columns {
+	c, onBoard := b.Locate(c)
+	if !onBoard {
 		return nil
 	}
 	return &b.Positions[c.Row*b.Columns+c.Column]
 }
+
+// Spans returns the candidate ranges which cover all of the specified
+// coordinates according to the board's topology, in order of preference. For
+// planar boards, this is just the bounding range of the coordinates. For boards
+// with wrapping edges, ranges may extend past the edges of the board (and
+// should be mapped back onto the board using Locate).
+func (b *Board) Spans(coords ...coord.Coord) []coord.Range {
+	return b.topology().Spans(coords, b.Rows, b.Columns)
+}
+
+func (b *Board) topology() Topology {
+	if b.Topology == nil {
+		return planarInstance
+	}
+	return b.Topology
+}
@@ -32,9 +32,25 @@ func ExampleWithLayout() {
 	fmt.Printf("The board: %v", board)
 }
 
+func ExampleWithShapedLayout() {
+	__, st, _, _, _, _ := AllPositionTypes()
+	xx := BlockedPositionType()
+
+	board := WithShapedLayout(Layout{
+		{xx, xx, __},
+		{xx, xx, __},
+		{__, __, st, __, __},
+		{xx, xx, __},
+		{xx, xx, __},
+	})
+
+	fmt.Printf("The board: %v", board)
+}
+
 func TestBoard(t *testing.T) {
 
 	__, st, dl, dw, tl, tw := AllPositionTypes()
+	xx := BlockedPositionType()
 
 	expectEmptyBoardWithLayout := func(t *testing.T, b Board, layout Layout) {
 		rows := len(layout)
@@ -101,6 +117,35 @@ func TestBoard(t *testing.T) {
 		})
 	})
 
+	t.Run("WithShapedLayout()", func(t *testing.T) {
+
+		t.Run("creates an empty board with the specified layout", func(t *testing.T) {
+			layout := Layout{
+				{xx, __, xx},
+				{__, st, __},
+				{xx, __, xx},
+			}
+
+			board := WithShapedLayout(layout)
+
+			expectEmptyBoardWithLayout(t, board, layout)
+		})
+
+		t.Run("fills out short rows with blocked positions to match the longest column", func(t *testing.T) {
+			board := WithShapedLayout(Layout{
+				{xx, xx, __},
+				{__, __, st, __, __},
+				{},
+			})
+
+			expectEmptyBoardWithLayout(t, board, Layout{
+				{xx, xx, __, xx, xx},
+				{__, __, st, __, __},
+				{xx, xx, xx, xx, xx},
+			})
+		})
+	})
+
 	t.Run("WithStandardLayout()", func(t *testing.T) {
 
 		t.Run("creates an empty board with a standardised layout", func(t *testing.T) {
@@ -126,15 +171,105 @@ func TestBoard(t *testing.T) {
 		})
 	})
 
+	t.Run("WithAppLayout()", func(t *testing.T) {
+
+		t.Run("creates an empty board with the app layout", func(t *testing.T) {
+			board := WithAppLayout()
+
+			expectEmptyBoardWithLayout(t, board, Layout{
+				{__, __, __, tw, __, __, tl, __, tl, __, __, tw, __, __, __},
+				{__, __, dl, __, __, dw, __, __, __, dw, __, __, dl, __, __},
+				{__, dl, __, __, dl, __, __, __, __, __, dl, __, __, dl, __},
+				{tw, __, __, tl, __, __, __, dw, __, __, __, tl, __, __, tw},
+				{__, __, dl, __, __, __, dl, __, dl, __, __, __, dl, __, __},
+				{__, dw, __, __, __, tl, __, __, __, tl, __, __, __, dw, __},
+				{tl, __, __, __, dl, __, __, __, __, __, dl, __, __, __, tl},
+				{__, __, __, dw, __, __, __, st, __, __, __, dw, __, __, __},
+				{tl, __, __, __, dl, __, __, __, __, __, dl, __, __, __, tl},
+				{__, dw, __, __, __, tl, __, __, __, tl, __, __, __, dw, __},
+				{__, __, dl, __, __, __, dl, __, dl, __, __, __, dl, __, __},
+				{tw, __, __, tl, __, __, __, dw, __, __, __, tl, __, __, tw},
+				{__, dl, __, __, dl, __, __, __, __, __, dl, __, __, dl, __},
+				{__, __, dl, __, __, dw, __, __, __, dw, __, __, dl, __, __},
+				{__, __, __, tw, __, __, tl, __, tl, __, __, tw, __, __, __},
+			})
+		})
+	})
+
+	t.Run("WithSuperLayout()", func(t *testing.T) {
+
+		t.Run("creates an empty 21x21 board with the super layout", func(t *testing.T) {
+			board := WithSuperLayout()
+
+			expectEmptyBoardWithLayout(t, board, SuperLayout())
+
+			if actual, expected := board.Rows, 21; actual != expected {
+				t.Errorf("Expected %d rows but got %d", expected, actual)
+			}
+			if actual, expected := board.Columns, 21; actual != expected {
+				t.Errorf("Expected %d columns but got %d", expected, actual)
+			}
+		})
+
+		t.Run("has a symmetrical layout with the start in the centre", func(t *testing.T) {
+			board := WithSuperLayout()
+			ql, qw := QuadPositionTypes()
+
+			for r := 0; r < board.Rows; r++ {
+				for c := 0; c < board.Columns; c++ {
+					pos := board.Position(coord.Make(r, c))
+					mirrors := []coord.Coord{
+						coord.Make(c, r),
+						coord.Make(board.Rows-1-r, c),
+						coord.Make(r, board.Columns-1-c),
+					}
+
+					for _, m := range mirrors {
+						if mirrored := board.Position(m); mirrored.Type != pos.Type {
+							t.Errorf("Expected %v to mirror %v ('%s') but was '%s'", m, coord.Make(r, c), pos.Type.Name(), mirrored.Type.Name())
+						}
+					}
+				}
+			}
+
+			if actual, expected := board.Position(coord.Make(10, 10)).Type, st; actual != expected {
+				t.Errorf("Expected '%s' at centre but got '%s'", expected.Name(), actual.Name())
+			}
+			if actual, expected := board.Position(coord.Make(0, 0)).Type, qw; actual != expected {
+				t.Errorf("Expected '%s' at corner but got '%s'", expected.Name(), actual.Name())
+			}
+			if actual, expected := board.Position(coord.Make(2, 5)).Type, ql; actual != expected {
+				t.Errorf("Expected '%s' but got '%s'", expected.Name(), actual.Name())
+			}
+		})
+	})
+
+	t.Run(".IsEmpty()", func(t *testing.T) {
+
+		t.Run("returns true only when there are no tiles on the board", func(t *testing.T) {
+			b := WithStandardLayout()
+
+			if !b.IsEmpty() {
+				t.Errorf("Expected new board to be empty")
+			}
+
+			b.Position(coord.Make(3, 4)).Tile = &tile.Tile{Letter: "A", Points: 1}
+
+			if b.IsEmpty() {
+				t.Errorf("Expected board with a tile to not be empty")
+			}
+		})
+	})
+
 	t.Run(".Neighbours()", func(t *testing.T) {
 
 		b := Board{
 			Rows:    3,
 			Columns: 3,
 			Positions: []Position{
-				{__, &tile.Tile{'A', 1}}, {__, &tile.Tile{'B', 1}}, {__, &tile.Tile{'C', 1}},
-				{__, &tile.Tile{'D', 1}}, {__, &tile.Tile{'E', 1}}, {__, &tile.Tile{'F', 1}},
-				{__, &tile.Tile{'G', 1}}, {__, &tile.Tile{'H', 1}}, {__, &tile.Tile{'I', 1}},
+				{__, &tile.Tile{Letter: "A", Points: 1}}, {__, &tile.Tile{Letter: "B", Points: 1}}, {__, &tile.Tile{Letter: "C", Points: 1}},
+				{__, &tile.Tile{Letter: "D", Points: 1}}, {__, &tile.Tile{Letter: "E", Points: 1}}, {__, &tile.Tile{Letter: "F", Points: 1}},
+				{__, &tile.Tile{Letter: "G", Points: 1}}, {__, &tile.Tile{Letter: "H", Points: 1}}, {__, &tile.Tile{Letter: "I", Points: 1}},
 			},
 		}
 
@@ -229,6 +364,21 @@ func TestBoard(t *testing.T) {
 			}
 		})
 
+		t.Run("wraps around the edges of a toroidal board", func(t *testing.T) {
+			b := b
+			b.Topology = ToroidalTopology()
+
+			if actual, expected := b.Position(coord.Make(-1, 0)), &b.Positions[2]; actual != expected {
+				t.Errorf("Expected -1,0 to correspond to position with '%s' type, but found %+v", expected.Type.Name(), actual)
+			}
+			if actual, expected := b.Position(coord.Make(0, 2)), &b.Positions[0]; actual != expected {
+				t.Errorf("Expected 0,2 to correspond to position with '%s' type, but found %+v", expected.Type.Name(), actual)
+			}
+			if actual, expected := b.Position(coord.Make(3, 3)), &b.Positions[3]; actual != expected {
+				t.Errorf("Expected 3,3 to correspond to position with '%s' type, but found %+v", expected.Type.Name(), actual)
+			}
+		})
+
 		t.Run("returns nil when out of bounds", func(t *testing.T) {
 			if actual, expected := b.Position(coord.Make(-1, 0)), (*Position)(nil); actual != expected {
 				t.Errorf("Expected -1,0 to be out of bounds but got position %+v", actual)
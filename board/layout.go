@@ -4,6 +4,84 @@ package board
 // from the top row down, from the leftmost column to  the rightmost.
 type Layout [][]PositionType
 
+// AppLayout returns the 15x15 board layout of the app variant, which has more
+// premium positions than the standard layout, placed differently.
+func AppLayout() Layout {
+	__, st, dl, dw, tl, tw := AllPositionTypes()
+
+	return Layout{
+		{__, __, __, tw, __, __, tl, __, tl, __, __, tw, __, __, __},
+		{__, __, dl, __, __, dw, __, __, __, dw, __, __, dl, __, __},
+		{__, dl, __, __, dl, __, __, __, __, __, dl, __, __, dl, __},
+		{tw, __, __, tl, __, __, __, dw, __, __, __, tl, __, __, tw},
+		{__, __, dl, __, __, __, dl, __, dl, __, __, __, dl, __, __},
+		{__, dw, __, __, __, tl, __, __, __, tl, __, __, __, dw, __},
+		{tl, __, __, __, dl, __, __, __, __, __, dl, __, __, __, tl},
+		{__, __, __, dw, __, __, __, st, __, __, __, dw, __, __, __},
+		{tl, __, __, __, dl, __, __, __, __, __, dl, __, __, __, tl},
+		{__, dw, __, __, __, tl, __, __, __, tl, __, __, __, dw, __},
+		{__, __, dl, __, __, __, dl, __, dl, __, __, __, dl, __, __},
+		{tw, __, __, tl, __, __, __, dw, __, __, __, tl, __, __, tw},
+		{__, dl, __, __, dl, __, __, __, __, __, dl, __, __, dl, __},
+		{__, __, dl, __, __, dw, __, __, __, dw, __, __, dl, __, __},
+		{__, __, __, tw, __, __, tl, __, tl, __, __, tw, __, __, __},
+	}
+}
+
+// StandardLayout returns the standardised 15x15 board layout.
+func StandardLayout() Layout {
+	__, st, dl, dw, tl, tw := AllPositionTypes()
+
+	return Layout{
+		{tw, __, __, dl, __, __, __, tw, __, __, __, dl, __, __, tw},
+		{__, dw, __, __, __, tl, __, __, __, tl, __, __, __, dw, __},
+		{__, __, dw, __, __, __, dl, __, dl, __, __, __, dw, __, __},
+		{dl, __, __, dw, __, __, __, dl, __, __, __, dw, __, __, dl},
+		{__, __, __, __, dw, __, __, __, __, __, dw, __, __, __, __},
+		{__, tl, __, __, __, tl, __, __, __, tl, __, __, __, tl, __},
+		{__, __, dl, __, __, __, dl, __, dl, __, __, __, dl, __, __},
+		{tw, __, __, dl, __, __, __, st, __, __, __, dl, __, __, tw},
+		{__, __, dl, __, __, __, dl, __, dl, __, __, __, dl, __, __},
+		{__, tl, __, __, __, tl, __, __, __, tl, __, __, __, tl, __},
+		{__, __, __, __, dw, __, __, __, __, __, dw, __, __, __, __},
+		{dl, __, __, dw, __, __, __, dl, __, __, __, dw, __, __, dl},
+		{__, __, dw, __, __, __, dl, __, dl, __, __, __, dw, __, __},
+		{__, dw, __, __, __, tl, __, __, __, tl, __, __, __, dw, __},
+		{tw, __, __, dl, __, __, __, tw, __, __, __, dl, __, __, tw},
+	}
+}
+
+// SuperLayout returns the 21x21 board layout of the super variant, which adds
+// quadruple letter and quadruple word score positions.
+func SuperLayout() Layout {
+	__, st, dl, dw, tl, tw := AllPositionTypes()
+	ql, qw := QuadPositionTypes()
+
+	return Layout{
+		{qw, __, __, dl, __, __, __, tw, __, __, dl, __, __, tw, __, __, __, dl, __, __, qw},
+		{__, dw, __, __, tl, __, __, __, dw, __, __, __, dw, __, __, __, tl, __, __, dw, __},
+		{__, __, dw, __, __, ql, __, __, __, dw, __, dw, __, __, __, ql, __, __, dw, __, __},
+		{dl, __, __, tw, __, __, dl, __, __, __, dw, __, __, __, dl, __, __, tw, __, __, dl},
+		{__, tl, __, __, dw, __, __, __, tl, __, __, __, tl, __, __, __, dw, __, __, tl, __},
+		{__, __, ql, __, __, dw, __, __, __, dl, __, dl, __, __, __, dw, __, __, ql, __, __},
+		{__, __, __, dl, __, __, dw, __, __, __, __, __, __, __, dw, __, __, dl, __, __, __},
+		{tw, __, __, __, __, __, __, dw, __, __, __, __, __, dw, __, __, __, __, __, __, tw},
+		{__, dw, __, __, tl, __, __, __, tl, __, __, __, tl, __, __, __, tl, __, __, dw, __},
+		{__, __, dw, __, __, dl, __, __, __, dl, __, dl, __, __, __, dl, __, __, dw, __, __},
+		{dl, __, __, dw, __, __, __, __, __, __, st, __, __, __, __, __, __, dw, __, __, dl},
+		{__, __, dw, __, __, dl, __, __, __, dl, __, dl, __, __, __, dl, __, __, dw, __, __},
+		{__, dw, __, __, tl, __, __, __, tl, __, __, __, tl, __, __, __, tl, __, __, dw, __},
+		{tw, __, __, __, __, __, __, dw, __, __, __, __, __, dw, __, __, __, __, __, __, tw},
+		{__, __, __, dl, __, __, dw, __, __, __, __, __, __, __, dw, __, __, dl, __, __, __},
+		{__, __, ql, __, __, dw, __, __, __, dl, __, dl, __, __, __, dw, __, __, ql, __, __},
+		{__, tl, __, __, dw, __, __, __, tl, __, __, __, tl, __, __, __, dw, __, __, tl, __},
+		{dl, __, __, tw, __, __, dl, __, __, __, dw, __, __, __, dl, __, __, tw, __, __, dl},
+		{__, __, dw, __, __, ql, __, __, __, dw, __, dw, __, __, __, ql, __, __, dw, __, __},
+		{__, dw, __, __, tl, __, __, __, dw, __, __, __, dw, __, __, __, tl, __, __, dw, __},
+		{qw, __, __, dl, __, __, __, tw, __, __, dl, __, __, tw, __, __, __, dl, __, __, qw},
+	}
+}
+
 // WidestRow returns the number of columns in the widest row of the layout.
 func (l Layout) WidestRow() int {
 	columns := 0
@@ -0,0 +1,27 @@
+package board
+
+import (
+	"math"
+
+	"github.com/mandykoh/scrubble/coord"
+)
+
+type planar struct {
+}
+
+func (t *planar) Locate(c coord.Coord, rows, columns int) (coord.Coord, bool) {
+	return c, c.Row >= 0 && c.Row < rows && c.Column >= 0 && c.Column < columns
+}
+
+func (t *planar) Spans(coords []coord.Coord, rows, columns int) []coord.Range {
+	bounds := coord.Range{
+		Min: coord.Make(math.MaxInt32, math.MaxInt32),
+		Max: coord.Make(math.MinInt32, math.MinInt32),
+	}
+
+	for _, c := range coords {
+		bounds = bounds.Include(c)
+	}
+
+	return []coord.Range{bounds}
+}
@@ -10,3 +10,9 @@ type Position struct {
 	Type PositionType
 	Tile *tile.Tile
 }
+
+// IsBlocked returns true if this position is a blocked position, which can
+// never hold a tile.
+func (p *Position) IsBlocked() bool {
+	return p.Type == blockedInstance
+}
@@ -0,0 +1,27 @@
+package board
+
+import (
+	"testing"
+)
+
+func TestPosition(t *testing.T) {
+
+	t.Run(".IsBlocked()", func(t *testing.T) {
+
+		t.Run("returns true only for blocked positions", func(t *testing.T) {
+			__, st, dl, dw, tl, tw := AllPositionTypes()
+
+			for _, posType := range []PositionType{__, st, dl, dw, tl, tw} {
+				p := Position{Type: posType}
+				if p.IsBlocked() {
+					t.Errorf("Expected '%s' position to not be blocked", posType.Name())
+				}
+			}
+
+			p := Position{Type: BlockedPositionType()}
+			if !p.IsBlocked() {
+				t.Errorf("Expected blocked position to be blocked")
+			}
+		})
+	})
+}
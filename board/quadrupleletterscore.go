@@ -0,0 +1,20 @@
+package board
+
+type quadrupleLetterScore struct {
+}
+
+func (p *quadrupleLetterScore) CountsAsConnected() bool {
+	return false
+}
+
+func (p *quadrupleLetterScore) ModifyTileScore(score int) int {
+	return score * 4
+}
+
+func (p *quadrupleLetterScore) ModifyWordScore(score int) int {
+	return score
+}
+
+func (p *quadrupleLetterScore) Name() string {
+	return "Quadruple Letter Score"
+}
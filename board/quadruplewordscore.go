@@ -0,0 +1,20 @@
+package board
+
+type quadrupleWordScore struct {
+}
+
+func (p *quadrupleWordScore) CountsAsConnected() bool {
+	return false
+}
+
+func (p *quadrupleWordScore) ModifyTileScore(score int) int {
+	return score
+}
+
+func (p *quadrupleWordScore) ModifyWordScore(score int) int {
+	return score * 4
+}
+
+func (p *quadrupleWordScore) Name() string {
+	return "Quadruple Word Score"
+}
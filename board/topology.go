@@ -0,0 +1,19 @@
+package board
+
+import "github.com/mandykoh/scrubble/coord"
+
+// Topology represents the way in which coordinates map onto the positions of a
+// board, and thus how the edges of a board behave.
+type Topology interface {
+
+	// Locate maps the specified coordinate onto a board with the given
+	// dimensions, returning the equivalent coordinate on the board and whether
+	// the coordinate corresponds to a position on the board at all.
+	Locate(c coord.Coord, rows, columns int) (located coord.Coord, onBoard bool)
+
+	// Spans returns the candidate ranges which cover all of the specified
+	// (on-board) coordinates, in order of preference. Ranges may extend past
+	// the edges of the board, in which case they should be mapped back onto the
+	// board using Locate.
+	Spans(coords []coord.Coord, rows, columns int) []coord.Range
+}
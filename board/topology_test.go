@@ -0,0 +1,121 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/mandykoh/scrubble/coord"
+)
+
+func TestPlanarTopology(t *testing.T) {
+	topology := PlanarTopology()
+
+	t.Run(".Locate()", func(t *testing.T) {
+
+		t.Run("returns on-board coordinates unchanged", func(t *testing.T) {
+			located, onBoard := topology.Locate(coord.Make(2, 3), 3, 4)
+
+			if !onBoard {
+				t.Errorf("Expected coordinate to be on the board")
+			}
+			if actual, expected := located, coord.Make(2, 3); actual != expected {
+				t.Errorf("Expected located coordinate %v but got %v", expected, actual)
+			}
+		})
+
+		t.Run("reports coordinates beyond the edges as off the board", func(t *testing.T) {
+			for _, c := range []coord.Coord{{-1, 0}, {0, -1}, {3, 0}, {0, 4}} {
+				if _, onBoard := topology.Locate(c, 3, 4); onBoard {
+					t.Errorf("Expected coordinate %v to be off the board", c)
+				}
+			}
+		})
+	})
+
+	t.Run(".Spans()", func(t *testing.T) {
+
+		t.Run("returns only the bounding range", func(t *testing.T) {
+			spans := topology.Spans([]coord.Coord{{1, 7}, {1, 2}, {1, 4}}, 15, 15)
+
+			if actual, expected := len(spans), 1; actual != expected {
+				t.Fatalf("Expected %d span but got %d", expected, actual)
+			}
+			if actual, expected := spans[0], (coord.Range{Min: coord.Make(1, 2), Max: coord.Make(1, 7)}); actual != expected {
+				t.Errorf("Expected span %v but got %v", expected, actual)
+			}
+		})
+	})
+}
+
+func TestToroidalTopology(t *testing.T) {
+	topology := ToroidalTopology()
+
+	t.Run(".Locate()", func(t *testing.T) {
+
+		t.Run("wraps coordinates beyond the edges around the board", func(t *testing.T) {
+			cases := []struct {
+				c, expected coord.Coord
+			}{
+				{coord.Make(2, 3), coord.Make(2, 3)},
+				{coord.Make(-1, 0), coord.Make(2, 0)},
+				{coord.Make(0, -1), coord.Make(0, 3)},
+				{coord.Make(3, 4), coord.Make(0, 0)},
+				{coord.Make(7, 9), coord.Make(1, 1)},
+			}
+
+			for _, c := range cases {
+				located, onBoard := topology.Locate(c.c, 3, 4)
+
+				if !onBoard {
+					t.Errorf("Expected coordinate %v to be on the board", c.c)
+				}
+				if actual, expected := located, c.expected; actual != expected {
+					t.Errorf("Expected coordinate %v to be located at %v but got %v", c.c, expected, actual)
+				}
+			}
+		})
+
+		t.Run("reports all coordinates as off a zero-sized board", func(t *testing.T) {
+			if _, onBoard := topology.Locate(coord.Make(0, 0), 0, 0); onBoard {
+				t.Errorf("Expected coordinate to be off the board")
+			}
+		})
+	})
+
+	t.Run(".Spans()", func(t *testing.T) {
+
+		t.Run("returns the shortest span first", func(t *testing.T) {
+			spans := topology.Spans([]coord.Coord{{1, 13}, {1, 0}, {1, 1}}, 15, 15)
+
+			if actual, expected := len(spans), 3; actual != expected {
+				t.Fatalf("Expected %d spans but got %d", expected, actual)
+			}
+			if actual, expected := spans[0], (coord.Range{Min: coord.Make(1, 13), Max: coord.Make(1, 16)}); actual != expected {
+				t.Errorf("Expected span %v but got %v", expected, actual)
+			}
+		})
+
+		t.Run("prefers spans which don't cross an edge", func(t *testing.T) {
+			spans := topology.Spans([]coord.Coord{{0, 2}, {0, 8}}, 10, 12)
+
+			if actual, expected := len(spans), 2; actual != expected {
+				t.Fatalf("Expected %d spans but got %d", expected, actual)
+			}
+			if actual, expected := spans[0], (coord.Range{Min: coord.Make(0, 2), Max: coord.Make(0, 8)}); actual != expected {
+				t.Errorf("Expected span %v but got %v", expected, actual)
+			}
+			if actual, expected := spans[1], (coord.Range{Min: coord.Make(0, 8), Max: coord.Make(0, 14)}); actual != expected {
+				t.Errorf("Expected span %v but got %v", expected, actual)
+			}
+		})
+
+		t.Run("returns non-linear spans for non-linear coordinates", func(t *testing.T) {
+			spans := topology.Spans([]coord.Coord{{0, 0}, {1, 1}}, 15, 15)
+
+			for _, s := range spans {
+				if s.IsLinear() {
+					t.Errorf("Expected span %v to be non-linear", s)
+				}
+			}
+		})
+	})
+}
@@ -0,0 +1,79 @@
+package board
+
+import (
+	"sort"
+
+	"github.com/mandykoh/scrubble/coord"
+)
+
+type toroidal struct {
+}
+
+func (t *toroidal) Locate(c coord.Coord, rows, columns int) (coord.Coord, bool) {
+	if rows <= 0 || columns <= 0 {
+		return c, false
+	}
+	return coord.Make(wrap(c.Row, rows), wrap(c.Column, columns)), true
+}
+
+func (t *toroidal) Spans(coords []coord.Coord, rows, columns int) (spans []coord.Range) {
+	if len(coords) == 0 {
+		return (&planar{}).Spans(coords, rows, columns)
+	}
+
+	rowValues := make([]int, len(coords))
+	colValues := make([]int, len(coords))
+	for i, c := range coords {
+		rowValues[i] = c.Row
+		colValues[i] = c.Column
+	}
+
+	for _, rowArc := range arcs(rowValues, rows) {
+		for _, colArc := range arcs(colValues, columns) {
+			spans = append(spans, coord.Range{
+				Min: coord.Make(rowArc[0], colArc[0]),
+				Max: coord.Make(rowArc[1], colArc[1]),
+			})
+		}
+	}
+
+	return
+}
+
+// arcs returns the candidate arcs around a ring of the specified size which
+// cover all the given values, shortest first. Each arc excludes exactly one of
+// the gaps between the values; arcs which cross the end of the ring are
+// returned with their end beyond the ring's size.
+func arcs(values []int, size int) (result [][2]int) {
+	var unique []int
+	seen := make(map[int]bool)
+	for _, v := range values {
+		v = wrap(v, size)
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	sort.Ints(unique)
+
+	last := len(unique) - 1
+	result = append(result, [2]int{unique[0], unique[last]})
+
+	for i := 0; i < last; i++ {
+		result = append(result, [2]int{unique[i+1], unique[i] + size})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i][1]-result[i][0] < result[j][1]-result[j][0]
+	})
+
+	return
+}
+
+func wrap(value, size int) int {
+	value %= size
+	if value < 0 {
+		value += size
+	}
+	return value
+}
@@ -11,6 +11,10 @@ const (
 	// PlayAlreadyChallengedReason indicates that a play has already been
 	// challenged.
 	PlayAlreadyChallengedReason
+
+	// ChallengesNotAllowedReason indicates that the rules in use don't allow
+	// plays to be challenged.
+	ChallengesNotAllowedReason
 )
 
 // InvalidChallengeReason indicates the reason for an InvalidChallengeError.
@@ -24,6 +28,8 @@ func (r InvalidChallengeReason) GoString() string {
 		return "NoPlayToChallengeReason"
 	case PlayAlreadyChallengedReason:
 		return "PlayAlreadyChallengedReason"
+	case ChallengesNotAllowedReason:
+		return "ChallengesNotAllowedReason"
 	default:
 		return "UnknownInvalidChallengeReason"
 	}
@@ -37,6 +43,8 @@ func (r InvalidChallengeReason) String() string {
 		return "NoPlayToChallenge"
 	case PlayAlreadyChallengedReason:
 		return "PlayAlreadyChallenged"
+	case ChallengesNotAllowedReason:
+		return "ChallengesNotAllowed"
 	default:
 		return "Unknown"
 	}
@@ -5,6 +5,13 @@ import (
 	"github.com/mandykoh/scrubble/history"
 )
 
+// Disallow implements a Validator for rules which don't allow challenges (for
+// example, where words are always validated when scoring). Every challenge is
+// rejected with ChallengesNotAllowedReason.
+func Disallow(lastPlay *history.Entry, isWordValid dict.Dictionary) (success bool, err error) {
+	return false, InvalidChallengeError{ChallengesNotAllowedReason}
+}
+
 // Validate determines whether the challenge to a play is legal, and whether it
 // would then be successful. A challenge succeeds if any of the words formed by
 // the play are invalid according to the dictionary.
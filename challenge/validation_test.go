@@ -8,6 +8,20 @@ import (
 	"github.com/mandykoh/scrubble/play"
 )
 
+func TestDisallow(t *testing.T) {
+
+	t.Run("returns an error for any challenge", func(t *testing.T) {
+		entries := []*history.Entry{nil, {Type: history.PlayEntryType}}
+
+		for _, e := range entries {
+			_, err := Disallow(e, func(string) bool { return false })
+			if actual, expected := err, (InvalidChallengeError{ChallengesNotAllowedReason}); actual != expected {
+				t.Errorf("Expected error %v but was %v", expected, err)
+			}
+		}
+	})
+}
+
 func TestValidate(t *testing.T) {
 	dictionary := func(word string) (valid bool) {
 		return strings.HasPrefix(word, "VALIDWORD")
@@ -2,19 +2,32 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
 	"os"
+	"strings"
+
+	"github.com/mandykoh/scrubble/dict"
 )
 
 func main() {
-	if len(os.Args) < 4 {
-		fmt.Fprintf(os.Stderr, "Usage: gendict <dictName> <wordFile> <outFile>\n")
+	localeCode := flag.String("locale", "en", "locale code used for case folding words")
+	stripAccents := flag.Bool("strip-accents", false, "remove accents from words")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: gendict [-locale code] [-strip-accents] <dictName> <wordFile> <outFile>\n")
+	}
+	flag.Parse()
+
+	if flag.NArg() < 3 {
+		flag.Usage()
 		os.Exit(1)
 	}
 
-	dictName := os.Args[1]
-	wordFilePath := os.Args[2]
-	outFilePath := os.Args[3]
+	dictName := flag.Arg(0)
+	wordFilePath := flag.Arg(1)
+	outFilePath := flag.Arg(2)
+
+	normalize := dict.LocaleNormalizer(*localeCode, *stripAccents)
 
 	wordFile, err := os.Open(wordFilePath)
 	if err != nil {
@@ -30,14 +43,20 @@ func main() {
 	}
 	defer outFile.Close()
 
-	fmt.Fprintf(outFile, "package scrubble\n\n")
+	fmt.Fprintf(outFile, "package dict\n\n")
 	fmt.Fprintf(outFile, "// This file was generated using cmd/gendict.go\n\n")
-	fmt.Fprintf(outFile, "var %s = map[string]bool {\n", dictName)
+	fmt.Fprintf(outFile, "var %s = map[string]bool{\n", dictName)
+
+	seen := make(map[string]bool)
 
 	scanner := bufio.NewScanner(wordFile)
 	for scanner.Scan() {
-		word := scanner.Text()
-		fmt.Fprintf(outFile, "\t\"%s\": true,\n", word)
+		word := normalize(strings.TrimSpace(scanner.Text()))
+		if word == "" || seen[word] {
+			continue
+		}
+		seen[word] = true
+		fmt.Fprintf(outFile, "\t%q: true,\n", word)
 	}
 
 	fmt.Fprintf(outFile, "}\n")
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mandykoh/scrubble/ratings"
+)
+
+func main() {
+	storePath := flag.String("store", "ratings.json", "file in which ratings are stored")
+	systemName := flag.String("system", "glicko2", "rating system to use (elo or glicko2)")
+	spreadScale := flag.Float64("spread", 0, "points spread which counts as a full win, for spread-aware ratings (0 to use wins and losses only)")
+	date := flag.String("date", "", "date of the recorded game, as YYYY-MM-DD (default today)")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: ratings [options] command\n\n")
+		fmt.Fprintf(os.Stderr, "Commands:\n")
+		fmt.Fprintf(os.Stderr, "  record NAME:SCORE NAME:SCORE...  record the result of a game\n")
+		fmt.Fprintf(os.Stderr, "  ladder                           print the current ladder\n")
+		fmt.Fprintf(os.Stderr, "  history NAME                     print a player's rating history\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	store, err := ratings.Open(*storePath)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	switch flag.Arg(0) {
+	case "record":
+		system, err := ratingSystem(*systemName, *spreadScale)
+		if err != nil {
+			exitWithError(err)
+		}
+		if store.System == "" {
+			store.System = *systemName
+		} else if store.System != *systemName {
+			exitWithError(fmt.Errorf("store uses the %s rating system, not %s", store.System, *systemName))
+		}
+
+		result, err := parseResult(flag.Args()[1:], *date)
+		if err != nil {
+			exitWithError(err)
+		}
+
+		if err := store.Record(system, result); err != nil {
+			exitWithError(err)
+		}
+		if err := store.Save(); err != nil {
+			exitWithError(err)
+		}
+		printLadder(store)
+
+	case "ladder":
+		printLadder(store)
+
+	case "history":
+		if flag.NArg() != 2 {
+			flag.Usage()
+			os.Exit(1)
+		}
+		if err := printHistory(store, flag.Arg(1)); err != nil {
+			exitWithError(err)
+		}
+
+	default:
+		flag.Usage()
+		os.Exit(1)
+	}
+}
+
+func exitWithError(err error) {
+	fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	os.Exit(1)
+}
+
+func parseResult(args []string, date string) (ratings.Result, error) {
+	result := ratings.Result{Date: time.Now()}
+
+	if date != "" {
+		d, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			return result, err
+		}
+		result.Date = d
+	}
+
+	if len(args) < 2 {
+		return result, fmt.Errorf("a result needs at least two players")
+	}
+
+	seen := make(map[string]bool, len(args))
+	for _, arg := range args {
+		sep := strings.LastIndex(arg, ":")
+		if sep <= 0 {
+			return result, fmt.Errorf("expected NAME:SCORE but got '%s'", arg)
+		}
+
+		score, err := strconv.Atoi(arg[sep+1:])
+		if err != nil {
+			return result, fmt.Errorf("invalid score in '%s'", arg)
+		}
+
+		name := arg[:sep]
+		if seen[name] {
+			return result, fmt.Errorf("player '%s' is named more than once", name)
+		}
+		seen[name] = true
+
+		result.Players = append(result.Players, name)
+		result.Scores = append(result.Scores, score)
+	}
+
+	return result, nil
+}
+
+func printHistory(store *ratings.Store, name string) error {
+	p, ok := store.Players[name]
+	if !ok {
+		return fmt.Errorf("no player named '%s'", name)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(w, "Date\tScore\tRating\tDeviation\t\n")
+	for _, h := range p.History {
+		fmt.Fprintf(w, "%s\t%d\t%.0f\t%.0f\t\n", h.Date.Format("2006-01-02"), h.Score, h.Rating.Value, h.Rating.Deviation)
+	}
+	return w.Flush()
+}
+
+func printLadder(store *ratings.Store) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(w, "#\tPlayer\tRating\tDeviation\tGames\t\n")
+	for i, p := range store.Ladder() {
+		fmt.Fprintf(w, "%d\t%s\t%.0f\t%.0f\t%d\t\n", i+1, p.Name, p.Rating.Value, p.Rating.Deviation, p.Rating.Games)
+	}
+	w.Flush()
+}
+
+func ratingSystem(name string, spreadScale float64) (ratings.System, error) {
+	switch name {
+	case "elo":
+		elo := ratings.DefaultElo()
+		elo.SpreadScale = spreadScale
+		return elo, nil
+	case "glicko2":
+		gl := ratings.DefaultGlicko2()
+		gl.SpreadScale = spreadScale
+		return gl, nil
+	}
+	return nil, fmt.Errorf("unknown rating system '%s'", name)
+}
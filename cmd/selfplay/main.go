@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/mandykoh/scrubble/cmd/selfplay/selfplay"
+	"github.com/mandykoh/scrubble/dict"
+	"github.com/mandykoh/scrubble/locale"
+	"github.com/mandykoh/scrubble/movegen"
+)
+
+var strategies = map[string]movegen.Strategy{
+	"highest": movegen.HighestScore,
+	"most":    movegen.MostTiles,
+	"random":  movegen.RandomMove,
+	"save":    movegen.SaveBlanks,
+}
+
+func main() {
+	games := flag.Int("games", 100, "number of games to play")
+	parallel := flag.Int("parallel", runtime.NumCPU(), "number of games to play in parallel")
+	seed := flag.Int64("seed", 1, "seed for the first game (each game uses seed + game index)")
+	strategyList := flag.String("strategies", "highest,highest", "comma separated strategy for each player (highest, most, random, or save)")
+	localeCode := flag.String("locale", "en", "locale of the tile distribution and default board layout")
+	layoutPath := flag.String("layout", "", "file containing a custom board layout")
+	lexiconPath := flag.String("lexicon", "", "word list file with one word per line (default English if not specified)")
+	maxRetries := flag.Int("retries", 0, "maximum number of moves to try each turn if the game rejects them (0 for no limit)")
+	dump := flag.Int("dump", -1, "index of a game whose full history should be written as JSON")
+	dumpPath := flag.String("dump-file", "", "file to write the dumped game to (standard output if not specified, with the summary written to standard error)")
+	flag.Parse()
+
+	if *dump != -1 && (*dump < 0 || *dump >= *games) {
+		exitWithError(fmt.Errorf("game %d can't be dumped (games are numbered from 0 to %d)", *dump, *games-1))
+	}
+
+	l, ok := locale.Lookup(*localeCode)
+	if !ok {
+		exitWithError(fmt.Errorf("unknown locale %q (available: %s)", *localeCode, strings.Join(locale.Codes(), ", ")))
+	}
+
+	cfg := selfplay.Config{
+		Distribution:   l.Distribution,
+		Layout:         l.Layout,
+		Seed:           *seed,
+		MaxMoveRetries: *maxRetries,
+	}
+
+	names := strings.Split(*strategyList, ",")
+	for _, name := range names {
+		strategy, ok := strategies[name]
+		if !ok {
+			exitWithError(fmt.Errorf("unknown strategy %q", name))
+		}
+		cfg.Strategies = append(cfg.Strategies, strategy)
+	}
+
+	if *layoutPath != "" {
+		f, err := os.Open(*layoutPath)
+		if err != nil {
+			exitWithError(err)
+		}
+		cfg.Layout, err = selfplay.ReadLayout(f)
+		f.Close()
+		if err != nil {
+			exitWithError(err)
+		}
+	}
+
+	if *lexiconPath == "" {
+		cfg.Words = dict.DefaultEnglishWordList()
+	} else {
+		f, err := os.Open(*lexiconPath)
+		if err != nil {
+			exitWithError(err)
+		}
+		cfg.Words, err = dict.LoadWordList(f, dict.LocaleNormalizer(*localeCode, false))
+		f.Close()
+		if err != nil {
+			exitWithError(err)
+		}
+	}
+	cfg.Generator = movegen.New(cfg.Words, l.Distribution.Alphabet())
+
+	results, err := selfplay.Run(cfg, *games, *parallel)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	summaryOut := os.Stdout
+	if *dump >= 0 && *dumpPath == "" {
+		summaryOut = os.Stderr
+	}
+	selfplay.Summarise(results, names).WriteText(summaryOut)
+
+	if *dump >= 0 {
+		out := os.Stdout
+		if *dumpPath != "" {
+			if out, err = os.Create(*dumpPath); err != nil {
+				exitWithError(err)
+			}
+			defer out.Close()
+		}
+
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(results[*dump]); err != nil {
+			exitWithError(err)
+		}
+	}
+}
+
+func exitWithError(err error) {
+	fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	os.Exit(1)
+}
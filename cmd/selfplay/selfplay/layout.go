@@ -0,0 +1,45 @@
+package selfplay
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mandykoh/scrubble/board"
+)
+
+// ReadLayout reads a board layout from the specified reader. Each line is a
+// row of the board, made up of whitespace separated position codes: "__" for
+// normal positions, "st" for the start, "dl", "dw", "tl", "tw", "ql", and "qw"
+// for premium positions, and "##" for blocked positions.
+func ReadLayout(r io.Reader) (board.Layout, error) {
+	__, st, dl, dw, tl, tw := board.AllPositionTypes()
+	ql, qw := board.QuadPositionTypes()
+	types := map[string]board.PositionType{
+		"__": __, "st": st, "dl": dl, "dw": dw, "tl": tl, "tw": tw, "ql": ql, "qw": qw,
+		"##": board.BlockedPositionType(),
+	}
+
+	var layout board.Layout
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		codes := strings.Fields(scanner.Text())
+		if len(codes) == 0 {
+			continue
+		}
+
+		var row []board.PositionType
+		for _, code := range codes {
+			t, ok := types[code]
+			if !ok {
+				return nil, fmt.Errorf("unknown position code %q in row %d", code, len(layout)+1)
+			}
+			row = append(row, t)
+		}
+		layout = append(layout, row)
+	}
+
+	return layout, scanner.Err()
+}
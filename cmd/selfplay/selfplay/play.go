@@ -0,0 +1,114 @@
+package selfplay
+
+import (
+	"math/rand"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/dict"
+	"github.com/mandykoh/scrubble/game"
+	"github.com/mandykoh/scrubble/history"
+	"github.com/mandykoh/scrubble/movegen"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// Config describes the games to be played.
+type Config struct {
+	Strategies     []movegen.Strategy
+	Distribution   tile.Distribution
+	Layout         board.Layout
+	Words          *dict.WordList
+	Generator      *movegen.Generator
+	Seed           int64
+	MaxMoveRetries int
+}
+
+// Result is the outcome of a single game.
+type Result struct {
+	Index     int             `json:"index"`
+	Seed      int64           `json:"seed"`
+	FirstSeat int             `json:"firstSeat"`
+	Scores    []int           `json:"scores"`
+	Turns     int             `json:"turns"`
+	History   history.History `json:"history"`
+}
+
+// Winners returns the indices of the seats with the highest score.
+func (r Result) Winners() (seats []int) {
+	for i, s := range r.Scores {
+		if len(seats) == 0 || s > r.Scores[seats[0]] {
+			seats = []int{i}
+		} else if s == r.Scores[seats[0]] {
+			seats = append(seats, i)
+		}
+	}
+	return
+}
+
+// Play plays the game with the specified index to completion, with each seat
+// choosing its moves using the corresponding strategy.
+func Play(cfg Config, index int) (Result, error) {
+	seed := cfg.Seed + int64(index)
+	rng := rand.New(rand.NewSource(seed))
+
+	g := game.New(tile.BagWithDistribution(cfg.Distribution), board.WithLayout(cfg.Layout))
+	g.Rules = g.Rules.WithDictionary(cfg.Words.Contains).WithDictionaryForScoring(true)
+
+	for range cfg.Strategies {
+		g.AddPlayer()
+	}
+	if err := g.Start(rng); err != nil {
+		return Result{}, err
+	}
+
+	result := Result{Index: index, Seed: seed, FirstSeat: g.CurrentSeatIndex}
+
+	gen := cfg.Generator.
+		WithPlacementValidator(g.Rules.ValidatePlacements).
+		WithWordScorer(func(placements play.Tiles, b *board.Board, isWordValid dict.Dictionary) (int, []play.Word, error) {
+			return g.Rules.ScoreWords(placements, b)
+		})
+	table := gen.Table(&g.Board)
+
+	for g.Phase == game.MainPhase {
+		s := g.CurrentSeat()
+		strategy := cfg.Strategies[g.CurrentSeatIndex]
+
+		if placements, ok := playMove(g, strategy(gen.Moves(&g.Board, s.Rack, table), rng), cfg.MaxMoveRetries); ok {
+			table.Update(placements)
+			continue
+		}
+
+		if len(g.Bag) >= g.Rules.RackSize() {
+			if err := g.ExchangeTiles(append([]tile.Tile{}, s.Rack...), rng); err == nil {
+				continue
+			}
+		}
+
+		if err := g.Pass(); err != nil {
+			return result, err
+		}
+	}
+
+	for _, s := range g.Seats {
+		result.Scores = append(result.Scores, s.Score)
+	}
+	result.Turns = len(g.History)
+	result.History = g.History
+
+	return result, nil
+}
+
+// playMove plays the first of the moves which the game accepts, trying at most
+// the specified number of moves (or all of them if zero).
+func playMove(g *game.Game, moves []movegen.Move, maxAttempts int) (placements play.Tiles, ok bool) {
+	for i, m := range moves {
+		if maxAttempts > 0 && i >= maxAttempts {
+			break
+		}
+		if _, err := g.Play(m.Placements); err == nil {
+			return m.Placements, true
+		}
+	}
+	return nil, false
+}
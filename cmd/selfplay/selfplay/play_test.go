@@ -0,0 +1,80 @@
+package selfplay
+
+import (
+	"testing"
+
+	"github.com/mandykoh/scrubble/dict"
+	"github.com/mandykoh/scrubble/locale"
+	"github.com/mandykoh/scrubble/movegen"
+)
+
+func testConfig() Config {
+	l, _ := locale.Lookup("en")
+	words := dict.NewWordList([]string{"AT", "TA", "CAT", "ACT", "EAT", "TEA", "TO", "NO", "ON", "IN", "IT", "AN", "RAT", "TAR", "ART", "TEN", "NET", "SET"}, dict.FoldCase("en"))
+
+	return Config{
+		Strategies:   []movegen.Strategy{movegen.HighestScore, movegen.HighestScore},
+		Distribution: l.Distribution,
+		Layout:       l.Layout,
+		Words:        words,
+		Generator:    movegen.New(words, l.Distribution.Alphabet()),
+		Seed:         42,
+	}
+}
+
+func TestPlay(t *testing.T) {
+
+	t.Run("seeds each game from the configured seed and its index", func(t *testing.T) {
+		r, err := Play(testConfig(), 3)
+
+		if err != nil {
+			t.Fatalf("Expected no error but got %v", err)
+		}
+		if actual, expected := r.Index, 3; actual != expected {
+			t.Errorf("Expected index %d but got %d", expected, actual)
+		}
+		if actual, expected := r.Seed, int64(45); actual != expected {
+			t.Errorf("Expected seed %d but got %d", expected, actual)
+		}
+	})
+
+	t.Run("plays a game to completion", func(t *testing.T) {
+		r, _ := Play(testConfig(), 0)
+
+		if actual, expected := len(r.Scores), 2; actual != expected {
+			t.Fatalf("Expected %d scores but got %d", expected, actual)
+		}
+		if actual, expected := r.Turns, len(r.History); actual != expected || actual == 0 {
+			t.Errorf("Expected %d turns but got %d", expected, actual)
+		}
+	})
+}
+
+func TestResult(t *testing.T) {
+
+	t.Run(".Winners()", func(t *testing.T) {
+		cases := []struct {
+			Scores   []int
+			Expected []int
+		}{
+			{[]int{300, 250}, []int{0}},
+			{[]int{250, 300, 280}, []int{1}},
+			{[]int{300, 250, 300}, []int{0, 2}},
+			{[]int{-10, -10}, []int{0, 1}},
+		}
+
+		for _, c := range cases {
+			winners := Result{Scores: c.Scores}.Winners()
+
+			if actual, expected := len(winners), len(c.Expected); actual != expected {
+				t.Errorf("Expected winners %v for scores %v but got %v", c.Expected, c.Scores, winners)
+				continue
+			}
+			for i, expected := range c.Expected {
+				if actual := winners[i]; actual != expected {
+					t.Errorf("Expected winners %v for scores %v but got %v", c.Expected, c.Scores, winners)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package selfplay
+
+import "sync"
+
+// Run plays the specified number of games using the given number of parallel
+// workers, returning the results in game order. Each game is seeded from the
+// configured seed and its index, so results are reproducible regardless of
+// parallelism.
+func Run(cfg Config, games, parallel int) ([]Result, error) {
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	results := make([]Result, games)
+	errs := make([]error, games)
+	indices := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < parallel; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				results[i], errs[i] = Play(cfg, i)
+			}
+		}()
+	}
+
+	for i := 0; i < games; i++ {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,40 @@
+package selfplay
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+
+	t.Run("returns results in game order", func(t *testing.T) {
+		results, err := Run(testConfig(), 4, 3)
+
+		if err != nil {
+			t.Fatalf("Expected no error but got %v", err)
+		}
+		for i, r := range results {
+			if actual, expected := r.Index, i; actual != expected {
+				t.Errorf("Expected result %d to have index %d but got %d", i, expected, actual)
+			}
+		}
+	})
+
+	t.Run("produces the same results regardless of parallelism", func(t *testing.T) {
+		serial, _ := Run(testConfig(), 4, 1)
+		parallel, _ := Run(testConfig(), 4, 4)
+
+		if !reflect.DeepEqual(serial, parallel) {
+			t.Errorf("Expected results %+v but got %+v", serial, parallel)
+		}
+	})
+
+	t.Run("produces the same result for a game as playing it alone", func(t *testing.T) {
+		results, _ := Run(testConfig(), 3, 2)
+		alone, _ := Play(testConfig(), 2)
+
+		if !reflect.DeepEqual(results[2], alone) {
+			t.Errorf("Expected result %+v but got %+v", alone, results[2])
+		}
+	})
+}
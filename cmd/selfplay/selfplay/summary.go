@@ -0,0 +1,113 @@
+package selfplay
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+)
+
+// Summary aggregates the results of many games.
+type Summary struct {
+	Games           int
+	StrategyNames   []string
+	Wins            []float64
+	Scores          [][]int
+	FirstPlayerWins float64
+	TurnsMin        int
+	TurnsMax        int
+	TurnsTotal      int
+}
+
+// Summarise aggregates the specified game results. Wins are shared equally
+// between tied players.
+func Summarise(results []Result, strategyNames []string) Summary {
+	s := Summary{
+		Games:         len(results),
+		StrategyNames: strategyNames,
+		Wins:          make([]float64, len(strategyNames)),
+		Scores:        make([][]int, len(strategyNames)),
+	}
+
+	for i, r := range results {
+		winners := r.Winners()
+		for _, w := range winners {
+			s.Wins[w] += 1 / float64(len(winners))
+			if w == r.FirstSeat {
+				s.FirstPlayerWins += 1 / float64(len(winners))
+			}
+		}
+
+		for seat, score := range r.Scores {
+			s.Scores[seat] = append(s.Scores[seat], score)
+		}
+
+		if i == 0 || r.Turns < s.TurnsMin {
+			s.TurnsMin = r.Turns
+		}
+		if r.Turns > s.TurnsMax {
+			s.TurnsMax = r.Turns
+		}
+		s.TurnsTotal += r.Turns
+	}
+
+	return s
+}
+
+// WriteText writes this summary to the specified writer as a human readable
+// report.
+func (s Summary) WriteText(w io.Writer) error {
+	if s.Games == 0 {
+		_, err := fmt.Fprintln(w, "No games played")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "Seat\tStrategy\tWin rate\tMean\tStd dev\tMin\tMedian\tMax\t")
+
+	for seat, name := range s.StrategyNames {
+		scores := append([]int{}, s.Scores[seat]...)
+		sort.Ints(scores)
+		mean, stdDev := meanAndStdDev(scores)
+
+		fmt.Fprintf(tw, "%d\t%s\t%.1f%%\t%.1f\t%.1f\t%d\t%.1f\t%d\t\n",
+			seat+1, name,
+			100*s.Wins[seat]/float64(s.Games),
+			mean, stdDev,
+			scores[0], median(scores), scores[len(scores)-1])
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d games, first player won %.1f%%, turns per game %d-%d (mean %.1f)\n",
+		s.Games,
+		100*s.FirstPlayerWins/float64(s.Games),
+		s.TurnsMin, s.TurnsMax, float64(s.TurnsTotal)/float64(s.Games))
+	return err
+}
+
+func meanAndStdDev(values []int) (mean, stdDev float64) {
+	for _, v := range values {
+		mean += float64(v)
+	}
+	mean /= float64(len(values))
+
+	for _, v := range values {
+		stdDev += (float64(v) - mean) * (float64(v) - mean)
+	}
+	stdDev = math.Sqrt(stdDev / float64(len(values)))
+
+	return
+}
+
+// median returns the middle of the specified sorted values, or the mean of the
+// two middle values if there are an even number of them.
+func median(sorted []int) float64 {
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return float64(sorted[mid-1]+sorted[mid]) / 2
+	}
+	return float64(sorted[mid])
+}
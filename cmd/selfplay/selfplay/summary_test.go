@@ -0,0 +1,123 @@
+package selfplay
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestSummarise(t *testing.T) {
+	results := []Result{
+		{FirstSeat: 0, Scores: []int{300, 250}, Turns: 20},
+		{FirstSeat: 1, Scores: []int{280, 280}, Turns: 24},
+		{FirstSeat: 1, Scores: []int{200, 310}, Turns: 18},
+	}
+
+	s := Summarise(results, []string{"highest", "save"})
+
+	t.Run("counts games", func(t *testing.T) {
+		if actual, expected := s.Games, 3; actual != expected {
+			t.Errorf("Expected %d games but got %d", expected, actual)
+		}
+	})
+
+	t.Run("shares wins equally between tied players", func(t *testing.T) {
+		for i, expected := range []float64{1.5, 1.5} {
+			if actual := s.Wins[i]; actual != expected {
+				t.Errorf("Expected %v wins for seat %d but got %v", expected, i, actual)
+			}
+		}
+	})
+
+	t.Run("counts wins by the first player", func(t *testing.T) {
+		if actual, expected := s.FirstPlayerWins, 2.5; actual != expected {
+			t.Errorf("Expected %v first player wins but got %v", expected, actual)
+		}
+	})
+
+	t.Run("collects the scores of each seat in game order", func(t *testing.T) {
+		for i, expected := range []int{250, 280, 310} {
+			if actual := s.Scores[1][i]; actual != expected {
+				t.Errorf("Expected score %d for game %d but got %d", expected, i, actual)
+			}
+		}
+	})
+
+	t.Run("tracks the range and total of turns", func(t *testing.T) {
+		if actual, expected := s.TurnsMin, 18; actual != expected {
+			t.Errorf("Expected minimum of %d turns but got %d", expected, actual)
+		}
+		if actual, expected := s.TurnsMax, 24; actual != expected {
+			t.Errorf("Expected maximum of %d turns but got %d", expected, actual)
+		}
+		if actual, expected := s.TurnsTotal, 62; actual != expected {
+			t.Errorf("Expected total of %d turns but got %d", expected, actual)
+		}
+	})
+}
+
+func TestSummary(t *testing.T) {
+
+	t.Run(".WriteText()", func(t *testing.T) {
+
+		t.Run("reports each seat's win rate and score distribution", func(t *testing.T) {
+			s := Summarise([]Result{
+				{Scores: []int{300, 250}, Turns: 20},
+				{Scores: []int{280, 290}, Turns: 24},
+			}, []string{"highest", "save"})
+
+			var out bytes.Buffer
+			s.WriteText(&out)
+
+			lines := strings.Split(out.String(), "\n")
+			if actual, expected := strings.Fields(lines[1]), []string{"1", "highest", "50.0%", "290.0", "10.0", "280", "290.0", "300"}; strings.Join(actual, " ") != strings.Join(expected, " ") {
+				t.Errorf("Expected row %v but got %v", expected, actual)
+			}
+		})
+
+		t.Run("reports when no games were played", func(t *testing.T) {
+			var out bytes.Buffer
+			Summarise(nil, []string{"highest"}).WriteText(&out)
+
+			if actual, expected := out.String(), "No games played\n"; actual != expected {
+				t.Errorf("Expected %q but got %q", expected, actual)
+			}
+		})
+	})
+}
+
+func TestMeanAndStdDev(t *testing.T) {
+	cases := []struct {
+		Values       []int
+		Mean, StdDev float64
+	}{
+		{[]int{300}, 300, 0},
+		{[]int{200, 400}, 300, 100},
+		{[]int{2, 4, 4, 4, 5, 5, 7, 9}, 5, 2},
+	}
+
+	for _, c := range cases {
+		mean, stdDev := meanAndStdDev(c.Values)
+
+		if mean != c.Mean || stdDev != c.StdDev {
+			t.Errorf("Expected mean %v and std dev %v for %v but got %v and %v", c.Mean, c.StdDev, c.Values, mean, stdDev)
+		}
+	}
+}
+
+func TestMedian(t *testing.T) {
+	cases := []struct {
+		Values   []int
+		Expected float64
+	}{
+		{[]int{300}, 300},
+		{[]int{100, 200, 400}, 200},
+		{[]int{100, 200, 300, 400}, 250},
+	}
+
+	for _, c := range cases {
+		if actual := median(c.Values); actual != c.Expected {
+			t.Errorf("Expected median %v for %v but got %v", c.Expected, c.Values, actual)
+		}
+	}
+}
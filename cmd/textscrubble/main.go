@@ -12,34 +12,65 @@ import (
 
 	"regexp"
 
+	"flag"
+
 	gt "github.com/buger/goterm"
 	"github.com/mandykoh/scrubble/cmd/textscrubble/textscrubble"
+	"github.com/mandykoh/scrubble/dict"
 	"github.com/mandykoh/scrubble/game"
+	"github.com/mandykoh/scrubble/transcript"
 )
 
 func main() {
-	if len(os.Args) < 3 || (os.Args[1] != "simple" && os.Args[1] != "challenge") {
-		fmt.Fprintf(os.Stderr, "Usage: textscrubble <mode> <player1_name> [player2_name] ... [playerN_name]\n")
+	super := flag.Bool("super", false, "play the super variant, with a 21x21 board and 200 tiles")
+	clabbers := flag.Bool("clabbers", false, "play Clabbers, where words only need to be anagrams of valid words, with hints disabled")
+	rated := flag.Bool("rated", false, "play a rated game, with hints disabled")
+	flag.Parse()
+	args := flag.Args()
+
+	if len(args) < 2 || (args[0] != "simple" && args[0] != "challenge" && args[0] != "app") {
+		fmt.Fprintf(os.Stderr, "Usage: textscrubble [-super] [-clabbers] [-rated] <mode> <player1_name> [player2_name] ... [playerN_name]\n")
 		fmt.Fprintf(os.Stderr, "\n  <mode> can be:\n\n")
 		fmt.Fprintf(os.Stderr, "     simple - words are automatically validated against the dictionary (only valid words can be played)\n")
 		fmt.Fprintf(os.Stderr, "  challenge - players can manually challenge a play (which is then validated with a dictionary)\n")
+		fmt.Fprintf(os.Stderr, "        app - word-game app style, with its own board and tiles, a 35 point bingo bonus and no challenges\n")
+		fmt.Fprintf(os.Stderr, "\n  -super plays the super variant, with a 21x21 board and 200 tiles\n")
+		fmt.Fprintf(os.Stderr, "  -clabbers plays Clabbers, where words only need to be anagrams of valid words, with hints disabled\n")
+		fmt.Fprintf(os.Stderr, "  -rated plays a rated game, with hints disabled\n")
 		os.Exit(1)
 	}
 
-	challengeEnabled := os.Args[1] == "challenge"
+	appEnabled := args[0] == "app"
+	challengeEnabled := args[0] == "challenge"
+	hintsEnabled := !*rated && !*clabbers
 
-	cmdExchangePattern := regexp.MustCompile(`^exchange ([a-zA-Z_]+)$`)
-	cmdPlayPattern := regexp.MustCompile(`^(across|down) (\d+) (\d+) ([a-zA-Z_]+)$`)
+	cmdExchangePattern := regexp.MustCompile(`^exchange (\S+)$`)
+	cmdPlayPattern := regexp.MustCompile(`^(across|down) (\d+) (\d+) (\S+)$`)
+	cmdPlayHintPattern := regexp.MustCompile(`^play (\d+)$`)
 
 	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
 
+	var annotate transcript.Annotator
+
 	g := game.NewWithDefaults()
-	g.Rules = g.Rules.WithDictionaryForScoring(!challengeEnabled)
+	if appEnabled {
+		g = game.NewAppWithDefaults()
+	} else if *super {
+		g = game.NewSuperWithDefaults()
+	}
+	if *clabbers {
+		index := dict.NewAlphagramIndex(dict.DefaultEnglishWordList())
+		g.Rules = g.Rules.WithDictionary(index.Contains)
+		annotate = transcript.Anagrams(index)
+	}
+	if !appEnabled {
+		g.Rules = g.Rules.WithDictionaryForScoring(!challengeEnabled)
+	}
 
 	var players []textscrubble.Player
 
-	for i := 2; i < len(os.Args); i++ {
-		players = append(players, textscrubble.Player{Name: os.Args[i]})
+	for _, name := range args[1:] {
+		players = append(players, textscrubble.Player{Name: name})
 		g.AddPlayer()
 	}
 
@@ -49,11 +80,19 @@ func main() {
 		os.Exit(1)
 	}
 
+	var hookWords *dict.WordList
+
+	var hints []game.Hint
+	var hintsEntries int
+
 	scanner := bufio.NewScanner(os.Stdin)
 
 	for {
 		s := g.CurrentSeat()
-		textscrubble.DrawGame(g, players)
+		if len(g.History) != hintsEntries {
+			hints = nil
+		}
+		textscrubble.DrawGame(g, players, hookWords)
 
 		gt.Println()
 
@@ -69,6 +108,23 @@ func main() {
 		} else if line == "shuffle" {
 			textscrubble.ShuffleRack(g, rng)
 
+		} else if line == "hooks" {
+			if hookWords == nil {
+				hookWords = dict.DefaultEnglishWordList()
+			} else {
+				hookWords = nil
+			}
+
+		} else if line == "transcript" {
+			textscrubble.DrawTranscript(g, players, annotate)
+
+		} else if hintsEnabled && line == "hint" {
+			hints = textscrubble.DrawHints(g, 5)
+			hintsEntries = len(g.History)
+
+		} else if matches := cmdPlayHintPattern.FindStringSubmatch(line); hintsEnabled && matches != nil {
+			textscrubble.PlayHint(matches[1], hints, g)
+
 		} else if challengeEnabled && line == "challenge" {
 			textscrubble.Challenge(g, rng)
 
@@ -85,12 +141,20 @@ func main() {
 			gt.Println("      pass - forfeit turn")
 			gt.Println("   shuffle - shuffle rack")
 			gt.Println("  exchange - exchange tiles, eg: exchange dg")
+			gt.Println("     hooks - show/hide the hooks for the words of the last play")
+			gt.Println("transcript - show the plays made so far")
+
+			if hintsEnabled {
+				gt.Println("      hint - show the highest scoring plays for your rack")
+				gt.Println("      play - make a play shown by hint, eg: play 1")
+			}
 
 			if challengeEnabled {
 				gt.Println(" challenge - challenge the last play")
 			}
 
 			gt.Println("\n  When specifying tiles, blank tiles will be matched if no other tiles match")
+			gt.Println("  Multi-letter tiles are matched automatically, or can be given in brackets, eg: [ch]")
 		}
 	}
 }
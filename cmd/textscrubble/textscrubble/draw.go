@@ -1,15 +1,25 @@
 package textscrubble
 
 import (
+	"bytes"
+	"fmt"
+	"strings"
+
 	gt "github.com/buger/goterm"
 	"github.com/mandykoh/scrubble/board"
 	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/dict"
 	"github.com/mandykoh/scrubble/game"
+	"github.com/mandykoh/scrubble/history"
+	"github.com/mandykoh/scrubble/query"
 	"github.com/mandykoh/scrubble/tile"
+	"github.com/mandykoh/scrubble/transcript"
 )
 
 func DrawBoard(b *board.Board) {
 	_, st, dl, dw, tl, tw := board.AllPositionTypes()
+	ql, qw := board.QuadPositionTypes()
+	xx := board.BlockedPositionType()
 
 	for r := 0; r < b.Rows; r++ {
 		offsetY := r*2 + 1
@@ -42,13 +52,24 @@ func DrawBoard(b *board.Board) {
 				gt.Print(gt.Background(gt.Color("tl", gt.GREEN), bg))
 			case tw:
 				gt.Print(gt.Background(gt.Color("tw", gt.YELLOW), bg))
+			case ql:
+				gt.Print(gt.Background(gt.Bold(gt.Color("ql", gt.BLUE)), bg))
+			case qw:
+				gt.Print(gt.Background(gt.Bold(gt.Color("qw", gt.RED)), bg))
+			case xx:
+				gt.Print(gt.Background(gt.Color("##", gt.MAGENTA), bg))
 			default:
 				gt.Print(gt.Background(" ", bg))
 			}
 
 			if pos.Tile != nil {
+				fg := gt.BLACK
+				if pos.Tile.Blank {
+					fg = gt.MAGENTA
+				}
+
 				gt.MoveCursor(offsetX+1, offsetY)
-				gt.Printf(gt.Bold(gt.Background(gt.Color("%c  ", gt.BLACK), gt.WHITE)), pos.Tile.Letter)
+				gt.Printf(gt.Bold(gt.Background(gt.Color("%-3s", fg), gt.WHITE)), pos.Tile.Letter)
 				gt.MoveCursor(offsetX+2, offsetY+1)
 				gt.Printf(gt.Background(gt.Color("%2d", gt.BLACK), gt.WHITE), pos.Tile.Points)
 			}
@@ -60,17 +81,21 @@ func DrawBoard(b *board.Board) {
 		gt.Print(gt.Color("|", gt.GREEN))
 	}
 
-	for i := 0; i < b.Rows; i++ {
+	for i := 0; i < b.Columns; i++ {
 		gt.MoveCursor(i*4+2, b.Rows*2+1)
 		gt.Printf(gt.Color("%d", gt.GREEN), i)
 	}
 }
 
-func DrawGame(g *game.Game, players []Player) {
+func DrawGame(g *game.Game, players []Player, hookWords *dict.WordList) {
 	gt.Clear()
 	DrawBoard(&g.Board)
 	DrawStats(g, players)
 
+	if hookWords != nil {
+		DrawHooks(g, hookWords)
+	}
+
 	gt.MoveCursor(0, g.Board.Rows*2+3)
 
 	if g.Phase == game.EndPhase {
@@ -82,18 +107,90 @@ func DrawGame(g *game.Game, players []Player) {
 	gt.Flush()
 }
 
+// DrawHints shows up to n of the highest scoring plays for the current
+// player's rack, numbered so that they can be made with PlayHint, and returns
+// them.
+func DrawHints(g *game.Game, n int) []game.Hint {
+	hints, err := g.Hints(n)
+	if err != nil {
+		gt.Println(gt.Color(err.Error(), gt.RED))
+		return nil
+	}
+
+	gt.Println()
+	if len(hints) == 0 {
+		gt.Println("No plays found")
+		return nil
+	}
+
+	for i, h := range hints {
+		var leave strings.Builder
+		for _, t := range h.Leave {
+			if t.Blank {
+				leave.WriteString("_")
+			} else {
+				leave.WriteString(t.Letter)
+			}
+		}
+
+		gt.Printf("%s %-18s %4d  %s\n",
+			gt.Color(fmt.Sprintf("play %d", i+1), gt.CYAN),
+			h.Notation,
+			h.Score,
+			leave.String())
+	}
+	return hints
+}
+
+func DrawHooks(g *game.Game, words *dict.WordList) {
+	offsetX := g.Board.Columns*4 + 7
+	offsetY := len(g.Seats) + 4
+
+	gt.MoveCursor(offsetX, offsetY)
+	gt.Print(gt.Color("Hooks for last play:", gt.CYAN))
+
+	if len(g.History) == 0 {
+		return
+	}
+
+	last := g.History.Last()
+	if last.Type != history.PlayEntryType {
+		return
+	}
+
+	for _, w := range last.WordsFormed {
+		var inner []string
+		for _, h := range query.InnerHooks(words, w.Word) {
+			inner = append(inner, strings.ToUpper(h.Word))
+		}
+
+		offsetY++
+		gt.MoveCursor(offsetX, offsetY)
+		gt.Printf("%s %s %s",
+			gt.Color(strings.ToUpper(strings.Join(query.FrontHooks(words, w.Word), "")), gt.YELLOW),
+			w.Word,
+			gt.Color(strings.ToUpper(strings.Join(query.BackHooks(words, w.Word), "")), gt.YELLOW))
+
+		if len(inner) > 0 {
+			offsetY++
+			gt.MoveCursor(offsetX+2, offsetY)
+			gt.Print(strings.Join(inner, " "))
+		}
+	}
+}
+
 func DrawRack(r tile.Rack) {
 	gt.Println()
 
 	for _, t := range r {
 		letter := t.Letter
-		if letter == ' ' {
-			letter = '_'
+		if t.Blank {
+			letter = "_"
 		}
 
 		gt.MoveCursorUp(1)
 		gt.MoveCursorForward(2)
-		gt.Printf(gt.Bold(gt.Background(gt.Color("%c  ", gt.BLACK), gt.WHITE)), letter)
+		gt.Printf(gt.Bold(gt.Background(gt.Color("%-3s", gt.BLACK), gt.WHITE)), letter)
 		gt.MoveCursorDown(1)
 		gt.MoveCursorBackward(3)
 		gt.Printf(gt.Background(gt.Color("%3d", gt.BLACK), gt.WHITE), t.Points)
@@ -109,3 +206,16 @@ func DrawStats(g *game.Game, players []Player) {
 		gt.Printf("%s %d", players[i].Name, s.Score)
 	}
 }
+
+func DrawTranscript(g *game.Game, players []Player, annotate transcript.Annotator) {
+	names := make([]string, len(players))
+	for i, p := range players {
+		names[i] = p.Name
+	}
+
+	var buf bytes.Buffer
+	transcript.Write(&buf, g.History, names, annotate)
+
+	gt.Println()
+	gt.Print(buf.String())
+}
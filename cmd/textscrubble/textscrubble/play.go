@@ -34,9 +34,13 @@ func Challenge(g *game.Game, rng *rand.Rand) {
 
 func ExchangeTiles(letters string, g *game.Game, rng *rand.Rand) {
 	seat := g.CurrentSeat()
-	tiles := LettersToRackTiles(letters, seat.Rack)
+	tiles, err := LettersToRackTiles(letters, GameAlphabet(g), seat.Rack)
+	if err != nil {
+		gt.Println(gt.Color(err.Error(), gt.RED))
+		return
+	}
 
-	err := g.ExchangeTiles(tiles, rng)
+	err = g.ExchangeTiles(tiles, rng)
 	if err != nil {
 		gt.Println(gt.Color(err.Error(), gt.RED))
 	} else {
@@ -45,9 +49,26 @@ func ExchangeTiles(letters string, g *game.Game, rng *rand.Rand) {
 	}
 }
 
-func LettersToPlacements(rowDir, colDir, row, col int, letters string, rack tile.Rack, b *board.Board) play.Tiles {
-	var placements play.Tiles
-	tiles := LettersToRackTiles(letters, rack)
+// GameAlphabet returns the alphabet of all the tiles in the game, whether in
+// the bag, on a rack, or on the board.
+func GameAlphabet(g *game.Game) tile.Alphabet {
+	tiles := append([]tile.Tile{}, g.Bag...)
+	for _, s := range g.Seats {
+		tiles = append(tiles, s.Rack...)
+	}
+	for _, p := range g.Board.Positions {
+		if p.Tile != nil {
+			tiles = append(tiles, *p.Tile)
+		}
+	}
+	return tile.AlphabetOf(tiles...)
+}
+
+func LettersToPlacements(rowDir, colDir, row, col int, letters string, alphabet tile.Alphabet, rack tile.Rack, b *board.Board) (placements play.Tiles, err error) {
+	tiles, err := LettersToRackTiles(letters, alphabet, rack)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, t := range tiles {
 		pos := b.Position(coord.Make(row, col))
@@ -58,32 +79,35 @@ func LettersToPlacements(rowDir, colDir, row, col int, letters string, rack tile
 			pos = b.Position(coord.Make(row, col))
 		}
 
+		c, _ := b.Locate(coord.Make(row, col))
+
 		placements = append(placements, play.TilePlacement{
 			Tile:  t,
-			Coord: coord.Make(row, col),
+			Coord: c,
 		})
 		row += rowDir
 		col += colDir
 	}
 
-	return placements
+	return placements, nil
 }
 
-func LettersToRackTiles(letters string, rack tile.Rack) (tiles []tile.Tile) {
-	lettersToFind := strings.Split(strings.ToUpper(letters), "")
+func LettersToRackTiles(letters string, alphabet tile.Alphabet, rack tile.Rack) (tiles []tile.Tile, err error) {
+	lettersToFind, err := alphabet.Tokenise(strings.ToUpper(letters))
+	if err != nil {
+		return nil, err
+	}
 
 LetterSearch:
-	for _, l := range lettersToFind {
-		letter := []rune(l)[0]
-
+	for _, letter := range lettersToFind {
 		for _, t := range rack {
-			if (letter == '_' && t.Letter == ' ') || t.Letter == letter {
+			if (letter == "_" && t.Blank) || (!t.Blank && t.Letter == letter) {
 				tiles = append(tiles, t)
 				continue LetterSearch
 			}
 		}
 
-		tiles = append(tiles, tile.Tile{Letter: letter, Points: 0})
+		tiles = append(tiles, tile.MakeBlank().Designate(letter))
 	}
 	return
 }
@@ -95,6 +119,19 @@ func Pass(g *game.Game) {
 	}
 }
 
+// PlayHint makes the play shown by DrawHints with the given (1-based) number,
+// using the hint's placements so that any blanks are designated as they were
+// when the play was found.
+func PlayHint(number string, hints []game.Hint, g *game.Game) {
+	n, _ := strconv.Atoi(number)
+	if n < 1 || n > len(hints) {
+		gt.Println(gt.Color("No such hint (use hint to show the plays for your rack)", gt.RED))
+		return
+	}
+
+	playPlacements(hints[n-1].Placements, g)
+}
+
 func PlayTiles(dir, row, col, letters string, g *game.Game) {
 	rowDir, colDir := 1, 0
 	if dir == "across" {
@@ -104,18 +141,13 @@ func PlayTiles(dir, row, col, letters string, g *game.Game) {
 	rowNum, _ := strconv.Atoi(row)
 	colNum, _ := strconv.Atoi(col)
 
-	seat := g.CurrentSeat()
-	placements := LettersToPlacements(rowDir, colDir, rowNum, colNum, letters, seat.Rack, &g.Board)
-
-	_, err := g.Play(placements)
+	placements, err := LettersToPlacements(rowDir, colDir, rowNum, colNum, letters, GameAlphabet(g), g.CurrentSeat().Rack, &g.Board)
 	if err != nil {
 		gt.Println(gt.Color(err.Error(), gt.RED))
-	} else {
-		DrawRack(seat.Rack)
-		if len(g.History.Last().TilesDrawn) > 0 {
-			gt.Printf("\n\nTiles replenished from bag")
-		}
+		return
 	}
+
+	playPlacements(placements, g)
 }
 
 func ShuffleRack(g *game.Game, rng *rand.Rand) {
@@ -126,3 +158,17 @@ func ShuffleRack(g *game.Game, rng *rand.Rand) {
 	})
 	DrawRack(seat.Rack)
 }
+
+func playPlacements(placements play.Tiles, g *game.Game) {
+	seat := g.CurrentSeat()
+
+	_, err := g.Play(placements)
+	if err != nil {
+		gt.Println(gt.Color(err.Error(), gt.RED))
+	} else {
+		DrawRack(seat.Rack)
+		if len(g.History.Last().TilesDrawn) > 0 {
+			gt.Printf("\n\nTiles replenished from bag")
+		}
+	}
+}
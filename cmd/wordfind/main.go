@@ -0,0 +1,98 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mandykoh/scrubble/dict"
+	"github.com/mandykoh/scrubble/locale"
+	"github.com/mandykoh/scrubble/query"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func main() {
+	lexiconPath := flag.String("lexicon", "", "word list file with one word per line (default English if not specified)")
+	localeCode := flag.String("locale", "en", "locale code used for normalizing words and splitting them into tiles")
+	stripAccents := flag.Bool("strip-accents", false, "remove accents from words")
+	anagram := flag.String("anagram", "", "letters which words must use exactly, with ? for blanks")
+	subAnagram := flag.String("subanagram", "", "letters which words must be made from, with ? for blanks")
+	pattern := flag.String("pattern", "", "pattern which words must fit, with ? for any letter and * for any letters")
+	contains := flag.String("contains", "", "letters which words must contain")
+	minLength := flag.Int("min", 0, "minimum word length")
+	maxLength := flag.Int("max", 0, "maximum word length")
+	hooks := flag.String("hooks", "", "word to show the front, back, and inner hooks of")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: wordfind [options]\n\nOptions:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	words, err := loadLexicon(*lexiconPath, dict.LocaleNormalizer(*localeCode, *stripAccents))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *hooks != "" {
+		printHooks(words, *hooks)
+		return
+	}
+
+	var alphabet tile.Alphabet
+	if l, ok := locale.Lookup(*localeCode); ok {
+		alphabet = query.NormalizedAlphabet(words, l.Distribution.Alphabet())
+	}
+
+	var filters []query.Filter
+	if *anagram != "" {
+		filters = append(filters, query.Anagram(alphabet, words.Normalize(*anagram)))
+	}
+	if *subAnagram != "" {
+		filters = append(filters, query.SubAnagram(alphabet, words.Normalize(*subAnagram)))
+	}
+	if *pattern != "" {
+		filters = append(filters, query.Pattern(alphabet, words.Normalize(*pattern)))
+	}
+	if *contains != "" {
+		filters = append(filters, query.Contains(alphabet, words.Normalize(*contains)))
+	}
+	if *minLength > 0 || *maxLength > 0 {
+		filters = append(filters, query.Length(alphabet, *minLength, *maxLength))
+	}
+
+	if len(filters) == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	for _, w := range query.Find(words, filters...) {
+		fmt.Println(w)
+	}
+}
+
+func loadLexicon(path string, normalize dict.Normalizer) (*dict.WordList, error) {
+	if path == "" {
+		return dict.DefaultEnglishWordList(), nil
+	}
+
+	lexiconFile, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer lexiconFile.Close()
+
+	return dict.LoadWordList(lexiconFile, normalize)
+}
+
+func printHooks(words *dict.WordList, word string) {
+	fmt.Printf("front: %s\n", strings.Join(query.FrontHooks(words, word), " "))
+	fmt.Printf(" back: %s\n", strings.Join(query.BackHooks(words, word), " "))
+
+	var inner []string
+	for _, h := range query.InnerHooks(words, word) {
+		inner = append(inner, h.Word)
+	}
+	fmt.Printf("inner: %s\n", strings.Join(inner, " "))
+}
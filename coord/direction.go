@@ -0,0 +1,64 @@
+package coord
+
+const (
+	// AcrossDirection indicates the direction of play from left to right.
+	AcrossDirection Direction = iota
+
+	// DownDirection indicates the direction of play from top to bottom.
+	DownDirection
+)
+
+// Direction represents a direction in which words are played on a board.
+type Direction int
+
+// GoString returns the Go syntax representation of the direction, or
+// UnknownDirection if it is not a valid direction.
+func (d Direction) GoString() string {
+	switch d {
+	case AcrossDirection:
+		return "AcrossDirection"
+	case DownDirection:
+		return "DownDirection"
+	default:
+		return "UnknownDirection"
+	}
+}
+
+// Next returns the coordinate which follows the specified coordinate in this
+// direction.
+func (d Direction) Next(c Coord) Coord {
+	if d == DownDirection {
+		return c.South()
+	}
+	return c.East()
+}
+
+// Perpendicular returns the direction at right angles to this one.
+func (d Direction) Perpendicular() Direction {
+	if d == DownDirection {
+		return AcrossDirection
+	}
+	return DownDirection
+}
+
+// Previous returns the coordinate which precedes the specified coordinate in
+// this direction.
+func (d Direction) Previous(c Coord) Coord {
+	if d == DownDirection {
+		return c.North()
+	}
+	return c.West()
+}
+
+// String returns the textual representation of the direction, or "Unknown" if
+// it is not a valid direction.
+func (d Direction) String() string {
+	switch d {
+	case AcrossDirection:
+		return "Across"
+	case DownDirection:
+		return "Down"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,106 @@
+package coord
+
+import "testing"
+
+func TestDirection(t *testing.T) {
+
+	t.Run(".GoString()", func(t *testing.T) {
+
+		t.Run("returns Go syntax for valid directions", func(t *testing.T) {
+			cases := []struct {
+				Direction    Direction
+				ExpectedName string
+			}{
+				{AcrossDirection, "AcrossDirection"},
+				{DownDirection, "DownDirection"},
+			}
+
+			for _, c := range cases {
+				if actual, expected := c.Direction.GoString(), c.ExpectedName; actual != expected {
+					t.Errorf("Expected direction '%s' but got '%s'", expected, actual)
+				}
+			}
+		})
+
+		t.Run("returns UnknownDirection for invalid directions", func(t *testing.T) {
+			cases := []Direction{999, -1}
+
+			for _, c := range cases {
+				if actual, expected := c.GoString(), "UnknownDirection"; actual != expected {
+					t.Errorf("Expected invalid direction but got '%s'", actual)
+				}
+			}
+		})
+	})
+
+	t.Run(".Next()", func(t *testing.T) {
+
+		t.Run("steps east when across", func(t *testing.T) {
+			if actual, expected := AcrossDirection.Next(Make(2, 3)), Make(2, 4); actual != expected {
+				t.Errorf("Expected %v but got %v", expected, actual)
+			}
+		})
+
+		t.Run("steps south when down", func(t *testing.T) {
+			if actual, expected := DownDirection.Next(Make(2, 3)), Make(3, 3); actual != expected {
+				t.Errorf("Expected %v but got %v", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".Perpendicular()", func(t *testing.T) {
+
+		t.Run("returns the other direction", func(t *testing.T) {
+			if actual, expected := AcrossDirection.Perpendicular(), DownDirection; actual != expected {
+				t.Errorf("Expected %v but got %v", expected, actual)
+			}
+			if actual, expected := DownDirection.Perpendicular(), AcrossDirection; actual != expected {
+				t.Errorf("Expected %v but got %v", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".Previous()", func(t *testing.T) {
+
+		t.Run("steps west when across", func(t *testing.T) {
+			if actual, expected := AcrossDirection.Previous(Make(2, 3)), Make(2, 2); actual != expected {
+				t.Errorf("Expected %v but got %v", expected, actual)
+			}
+		})
+
+		t.Run("steps north when down", func(t *testing.T) {
+			if actual, expected := DownDirection.Previous(Make(2, 3)), Make(1, 3); actual != expected {
+				t.Errorf("Expected %v but got %v", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".String()", func(t *testing.T) {
+
+		t.Run("returns name of valid directions", func(t *testing.T) {
+			cases := []struct {
+				Direction    Direction
+				ExpectedName string
+			}{
+				{AcrossDirection, "Across"},
+				{DownDirection, "Down"},
+			}
+
+			for _, c := range cases {
+				if actual, expected := c.Direction.String(), c.ExpectedName; actual != expected {
+					t.Errorf("Expected direction '%s' but got '%s'", expected, actual)
+				}
+			}
+		})
+
+		t.Run("returns 'Unknown' for invalid directions", func(t *testing.T) {
+			cases := []Direction{999, -1}
+
+			for _, c := range cases {
+				if actual, expected := c.String(), "Unknown"; actual != expected {
+					t.Errorf("Expected invalid direction but got '%s'", actual)
+				}
+			}
+		})
+	})
+}
@@ -0,0 +1,26 @@
+package crosscheck
+
+// Check represents the cross-check for a single empty position, for plays made
+// in a particular direction. A tile placed there by such a play also forms a
+// word in the perpendicular direction if the position has neighbouring tiles
+// in that direction; the cross-check records which letters would make that
+// perpendicular word valid.
+type Check struct {
+
+	// Constrained is true if a perpendicular word would be formed by placing a
+	// tile at the position, and thus only the Letters are allowed.
+	Constrained bool
+
+	// Letters is the set of letters which form a valid perpendicular word.
+	Letters map[string]bool
+
+	// Score is the total points of the existing tiles in the perpendicular word
+	// (not including the tile being placed, or any premiums).
+	Score int
+}
+
+// Allows returns true if a tile with the specified letter may be placed at the
+// position without forming an invalid perpendicular word.
+func (c Check) Allows(letter string) bool {
+	return !c.Constrained || c.Letters[letter]
+}
@@ -0,0 +1,250 @@
+package crosscheck
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/dict"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+var directions = [...]coord.Direction{coord.AcrossDirection, coord.DownDirection}
+
+// Table holds the anchors and cross-checks of a board, as needed for finding
+// moves. Anchors are the empty positions from which a play must be built (the
+// positions adjacent to existing tiles, or the start positions of an empty
+// board). Cross-checks record which letters may be placed at each empty
+// position without forming invalid perpendicular words.
+//
+// A Table is created for a board with New, and should then be kept up to date
+// by calling Update whenever tiles are placed on or removed from that board.
+type Table struct {
+	board        *board.Board
+	alphabet     tile.Alphabet
+	isWordValid  dict.Dictionary
+	startAnchors bool
+	anchors      map[coord.Coord]bool
+	checks       [len(directions)]map[coord.Coord]Check
+}
+
+// New returns a Table of anchors and cross-checks for the specified board,
+// using the given alphabet of letters and dictionary to determine which
+// letters form valid perpendicular words.
+func New(b *board.Board, alphabet tile.Alphabet, isWordValid dict.Dictionary) *Table {
+	t := &Table{
+		board:       b,
+		alphabet:    alphabet,
+		isWordValid: isWordValid,
+		anchors:     make(map[coord.Coord]bool),
+	}
+	for i := range t.checks {
+		t.checks[i] = make(map[coord.Coord]Check)
+	}
+
+	t.startAnchors = b.IsEmpty()
+	if t.startAnchors {
+		t.addStartAnchors()
+	}
+
+	for row := 0; row < b.Rows; row++ {
+		for col := 0; col < b.Columns; col++ {
+			t.refresh(coord.Make(row, col))
+		}
+	}
+
+	return t
+}
+
+// Anchors returns the coordinates of all anchor positions, in row-major order.
+func (t *Table) Anchors() []coord.Coord {
+	anchors := make([]coord.Coord, 0, len(t.anchors))
+	for c := range t.anchors {
+		anchors = append(anchors, c)
+	}
+
+	sort.Slice(anchors, func(i, j int) bool {
+		if anchors[i].Row != anchors[j].Row {
+			return anchors[i].Row < anchors[j].Row
+		}
+		return anchors[i].Column < anchors[j].Column
+	})
+
+	return anchors
+}
+
+// Check returns the cross-check for plays made in the specified direction at
+// the position with the specified coordinate. Positions which don't have any
+// perpendicular neighbouring tiles (or which aren't empty) are unconstrained.
+func (t *Table) Check(c coord.Coord, d coord.Direction) Check {
+	c, _ = t.board.Locate(c)
+	return t.checks[d][c]
+}
+
+// IsAnchor returns true if the position with the specified coordinate is an
+// anchor.
+func (t *Table) IsAnchor(c coord.Coord) bool {
+	c, _ = t.board.Locate(c)
+	return t.anchors[c]
+}
+
+// Update brings the table up to date after the specified placements have been
+// placed on the board (eg with play.Tiles.Place) or removed from it again (eg
+// after a successful challenge). Only the positions affected by the changed
+// coordinates are recomputed.
+func (t *Table) Update(placements play.Tiles) {
+	wasEmpty := t.startAnchors
+	t.startAnchors = t.board.IsEmpty()
+
+	if wasEmpty != t.startAnchors {
+		t.anchors = make(map[coord.Coord]bool)
+		if t.startAnchors {
+			t.addStartAnchors()
+		}
+	}
+
+	for _, p := range placements {
+		for _, c := range t.affectedBy(p.Coord) {
+			t.refresh(c)
+		}
+	}
+}
+
+func (t *Table) addStartAnchors() {
+	_, st, _, _, _, _ := board.AllPositionTypes()
+
+	var open []coord.Coord
+	for row := 0; row < t.board.Rows; row++ {
+		for col := 0; col < t.board.Columns; col++ {
+			c := coord.Make(row, col)
+			pos := t.board.Position(c)
+
+			if pos.Type == st {
+				t.anchors[c] = true
+			} else if !pos.IsBlocked() {
+				open = append(open, c)
+			}
+		}
+	}
+
+	// Boards without start positions can be started from anywhere
+	if len(t.anchors) == 0 {
+		for _, c := range open {
+			t.anchors[c] = true
+		}
+	}
+}
+
+// affectedBy returns the coordinates whose anchor status or cross-checks may
+// depend on the position at the specified coordinate: the position itself,
+// and the positions just beyond each end of the lines of tiles through it.
+func (t *Table) affectedBy(c coord.Coord) []coord.Coord {
+	c, onBoard := t.board.Locate(c)
+	if !onBoard {
+		return nil
+	}
+
+	affected := []coord.Coord{c}
+
+	for _, d := range directions {
+		steps := []func(coord.Coord) coord.Coord{d.Previous, d.Next}
+		for _, step := range steps {
+			next := c
+			for i := t.lineLength(d); i > 0; i-- {
+				next = step(next)
+				pos := t.board.Position(next)
+				if pos == nil {
+					break
+				}
+				if pos.Tile == nil {
+					located, _ := t.board.Locate(next)
+					affected = append(affected, located)
+					break
+				}
+			}
+		}
+	}
+
+	return affected
+}
+
+// crossWord returns the letters of the existing tiles before and after the
+// position at the specified coordinate in the specified direction, and their
+// total points.
+func (t *Table) crossWord(c coord.Coord, d coord.Direction) (prefix, suffix string, found bool, score int) {
+	var before, after []string
+	limit := t.lineLength(d) - 1
+
+	for next := d.Next(c); len(after) < limit; next = d.Next(next) {
+		pos := t.board.Position(next)
+		if pos == nil || pos.Tile == nil {
+			break
+		}
+		after = append(after, pos.Tile.Letter)
+		score += pos.Tile.Points
+	}
+
+	for prev := d.Previous(c); len(before)+len(after) < limit; prev = d.Previous(prev) {
+		pos := t.board.Position(prev)
+		if pos == nil || pos.Tile == nil {
+			break
+		}
+		before = append(before, pos.Tile.Letter)
+		score += pos.Tile.Points
+	}
+
+	for i, j := 0, len(before)-1; i < j; i, j = i+1, j-1 {
+		before[i], before[j] = before[j], before[i]
+	}
+
+	return strings.Join(before, ""), strings.Join(after, ""), len(before)+len(after) > 0, score
+}
+
+func (t *Table) lineLength(d coord.Direction) int {
+	if d == coord.DownDirection {
+		return t.board.Rows
+	}
+	return t.board.Columns
+}
+
+// refresh recomputes the anchor status and cross-checks of the position at the
+// specified (located) coordinate.
+func (t *Table) refresh(c coord.Coord) {
+	pos := t.board.Position(c)
+	if pos == nil {
+		return
+	}
+
+	for i := range t.checks {
+		delete(t.checks[i], c)
+	}
+	if !t.startAnchors {
+		delete(t.anchors, c)
+	}
+
+	if pos.Tile != nil || pos.IsBlocked() {
+		delete(t.anchors, c)
+		return
+	}
+
+	if !t.startAnchors && t.board.NeighbourHasTile(c) {
+		t.anchors[c] = true
+	}
+
+	for _, d := range directions {
+		prefix, suffix, found, score := t.crossWord(c, d.Perpendicular())
+		if !found {
+			continue
+		}
+
+		check := Check{Constrained: true, Letters: make(map[string]bool), Score: score}
+		for _, letter := range t.alphabet {
+			if t.isWordValid(prefix + letter + suffix) {
+				check.Letters[letter] = true
+			}
+		}
+		t.checks[d][c] = check
+	}
+}
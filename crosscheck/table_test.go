@@ -0,0 +1,196 @@
+package crosscheck
+
+import (
+	"testing"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func TestTable(t *testing.T) {
+	__, st, _, _, _, _ := board.AllPositionTypes()
+	xx := board.BlockedPositionType()
+
+	alphabet := tile.Alphabet{"A", "C", "O", "T"}
+
+	dictionary := func(word string) bool {
+		switch word {
+		case "AT", "CAT", "COT", "TA", "TO":
+			return true
+		}
+		return false
+	}
+
+	setupBoard := func() board.Board {
+		return board.WithLayout(board.Layout{
+			{__, __, __, __, __},
+			{__, __, __, __, __},
+			{__, __, st, __, xx},
+			{__, __, __, __, __},
+			{__, __, __, __, __},
+		})
+	}
+
+	expectAnchors := func(t *testing.T, table *Table, expected ...coord.Coord) {
+		t.Helper()
+
+		anchors := table.Anchors()
+		if actual, expectedLen := len(anchors), len(expected); actual != expectedLen {
+			t.Fatalf("Expected %d anchors but found %d: %v", expectedLen, actual, anchors)
+		}
+		for i, e := range expected {
+			if anchors[i] != e {
+				t.Errorf("Expected anchor %v in position %d but found %v", e, i, anchors[i])
+			}
+		}
+	}
+
+	expectLetters := func(t *testing.T, check Check, expected ...string) {
+		t.Helper()
+
+		if !check.Constrained {
+			t.Fatalf("Expected check to be constrained")
+		}
+		if actual, expectedLen := len(check.Letters), len(expected); actual != expectedLen {
+			t.Errorf("Expected %d allowed letters but found %d: %v", expectedLen, actual, check.Letters)
+		}
+		for _, letter := range expected {
+			if !check.Allows(letter) {
+				t.Errorf("Expected letter '%s' to be allowed", letter)
+			}
+		}
+	}
+
+	t.Run("New()", func(t *testing.T) {
+
+		t.Run("uses start positions as anchors on an empty board", func(t *testing.T) {
+			b := setupBoard()
+			table := New(&b, alphabet, dictionary)
+
+			expectAnchors(t, table, coord.Make(2, 2))
+		})
+
+		t.Run("uses all open positions as anchors on an empty board without start positions", func(t *testing.T) {
+			b := board.WithLayout(board.Layout{
+				{__, xx},
+				{__, __},
+			})
+			table := New(&b, alphabet, dictionary)
+
+			expectAnchors(t, table, coord.Make(0, 0), coord.Make(1, 0), coord.Make(1, 1))
+		})
+
+		t.Run("uses empty positions next to tiles as anchors", func(t *testing.T) {
+			b := setupBoard()
+			b.Position(coord.Make(2, 2)).Tile = &tile.Tile{Letter: "A", Points: 1}
+			b.Position(coord.Make(2, 3)).Tile = &tile.Tile{Letter: "T", Points: 1}
+			table := New(&b, alphabet, dictionary)
+
+			expectAnchors(t, table,
+				coord.Make(1, 2), coord.Make(1, 3),
+				coord.Make(2, 1),
+				coord.Make(3, 2), coord.Make(3, 3),
+			)
+		})
+
+		t.Run("computes cross-checks for positions with perpendicular tiles", func(t *testing.T) {
+			b := setupBoard()
+			b.Position(coord.Make(2, 2)).Tile = &tile.Tile{Letter: "A", Points: 1}
+			b.Position(coord.Make(2, 3)).Tile = &tile.Tile{Letter: "T", Points: 2}
+			table := New(&b, alphabet, dictionary)
+
+			check := table.Check(coord.Make(2, 1), coord.DownDirection)
+			expectLetters(t, check, "C")
+			if actual, expected := check.Score, 3; actual != expected {
+				t.Errorf("Expected cross-score of %d but got %d", expected, actual)
+			}
+
+			expectLetters(t, table.Check(coord.Make(1, 2), coord.AcrossDirection), "T")
+			expectLetters(t, table.Check(coord.Make(3, 3), coord.AcrossDirection), "A", "O")
+		})
+
+		t.Run("leaves positions without perpendicular tiles unconstrained", func(t *testing.T) {
+			b := setupBoard()
+			b.Position(coord.Make(2, 2)).Tile = &tile.Tile{Letter: "A", Points: 1}
+			table := New(&b, alphabet, dictionary)
+
+			check := table.Check(coord.Make(2, 1), coord.AcrossDirection)
+			if check.Constrained {
+				t.Errorf("Expected check to be unconstrained")
+			}
+			if !check.Allows("Z") {
+				t.Errorf("Expected any letter to be allowed")
+			}
+		})
+	})
+
+	t.Run(".Update()", func(t *testing.T) {
+
+		t.Run("matches a freshly computed table after tiles are placed", func(t *testing.T) {
+			b := setupBoard()
+			table := New(&b, alphabet, dictionary)
+
+			plays := []play.Tiles{
+				{
+					{tile.Make('A', 1), coord.Make(2, 2)},
+					{tile.Make('T', 1), coord.Make(2, 3)},
+				},
+				{
+					{tile.Make('C', 3), coord.Make(1, 2)},
+					{tile.Make('T', 1), coord.Make(3, 2)},
+				},
+			}
+
+			for _, p := range plays {
+				p.Place(&b)
+				table.Update(p)
+				expectSameAsFresh(t, table, &b, alphabet, dictionary)
+			}
+		})
+
+		t.Run("matches a freshly computed table after tiles are removed", func(t *testing.T) {
+			b := setupBoard()
+			table := New(&b, alphabet, dictionary)
+
+			placements := play.Tiles{
+				{tile.Make('A', 1), coord.Make(2, 2)},
+				{tile.Make('T', 1), coord.Make(2, 3)},
+			}
+			placements.Place(&b)
+			table.Update(placements)
+
+			for _, p := range placements {
+				b.Position(p.Coord).Tile = nil
+			}
+			table.Update(placements)
+
+			expectSameAsFresh(t, table, &b, alphabet, dictionary)
+			expectAnchors(t, table, coord.Make(2, 2))
+		})
+	})
+}
+
+func expectSameAsFresh(t *testing.T, table *Table, b *board.Board, alphabet tile.Alphabet, dictionary func(string) bool) {
+	t.Helper()
+
+	fresh := New(b, alphabet, dictionary)
+
+	for row := 0; row < b.Rows; row++ {
+		for col := 0; col < b.Columns; col++ {
+			c := coord.Make(row, col)
+
+			if actual, expected := table.IsAnchor(c), fresh.IsAnchor(c); actual != expected {
+				t.Errorf("Expected anchor status %v at %v but got %v", expected, c, actual)
+			}
+
+			for _, d := range directions {
+				actual, expected := table.Check(c, d), fresh.Check(c, d)
+				if actual.Constrained != expected.Constrained || actual.Score != expected.Score || len(actual.Letters) != len(expected.Letters) {
+					t.Errorf("Expected check %v at %v for %v but got %v", expected, c, d, actual)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package dict
+
+import (
+	"sort"
+	"strings"
+)
+
+// Alphagram returns the letters of the specified word in sorted order, so that
+// words which are anagrams of each other have the same alphagram.
+func Alphagram(word string) string {
+	letters := strings.Split(word, "")
+	sort.Strings(letters)
+	return strings.Join(letters, "")
+}
+
+// AlphagramIndex indexes the words of a WordList by their alphagrams, so that
+// strings can be checked for being anagrams of valid words. This allows games
+// of Clabbers, where any arrangement of a valid word's letters may be played.
+type AlphagramIndex struct {
+	words    *WordList
+	anagrams map[string][]string
+}
+
+// NewAlphagramIndex returns an index of the words in the specified WordList.
+func NewAlphagramIndex(words *WordList) *AlphagramIndex {
+	index := &AlphagramIndex{
+		words:    words,
+		anagrams: make(map[string][]string),
+	}
+
+	for _, w := range words.Words() {
+		a := Alphagram(w)
+		index.anagrams[a] = append(index.anagrams[a], w)
+	}
+
+	return index
+}
+
+// Anagrams returns the valid words (as normalized by the indexed WordList)
+// which are anagrams of the specified string, in sorted order. The string
+// itself is included if it is a valid word.
+func (a *AlphagramIndex) Anagrams(word string) []string {
+	return a.anagrams[Alphagram(a.words.Normalize(word))]
+}
+
+// Contains returns whether the specified string, once normalized, is an
+// anagram of any valid word. This satisfies the Dictionary function type, and
+// so can be used wherever a Dictionary is needed (eg
+// game.Rules.WithDictionary(index.Contains)).
+func (a *AlphagramIndex) Contains(word string) (valid bool) {
+	return len(a.Anagrams(word)) > 0
+}
@@ -0,0 +1,66 @@
+package dict
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAlphagram(t *testing.T) {
+
+	t.Run("returns the letters of a word in sorted order", func(t *testing.T) {
+		cases := []struct {
+			Word, Expected string
+		}{
+			{"", ""},
+			{"retains", "aeinrst"},
+			{"stainer", "aeinrst"},
+			{"éa", "aé"},
+		}
+
+		for _, c := range cases {
+			if actual := Alphagram(c.Word); actual != c.Expected {
+				t.Errorf("Expected alphagram of '%s' to be '%s' but got '%s'", c.Word, c.Expected, actual)
+			}
+		}
+	})
+}
+
+func TestAlphagramIndex(t *testing.T) {
+	index := NewAlphagramIndex(NewWordList([]string{"RETAINS", "stainer", "nastier", "cat", "act", "dog"}, strings.ToLower))
+
+	t.Run(".Anagrams()", func(t *testing.T) {
+
+		t.Run("returns the normalized valid anagrams in sorted order", func(t *testing.T) {
+			anagrams := index.Anagrams("ERTAINS")
+
+			if actual, expected := strings.Join(anagrams, " "), "nastier retains stainer"; actual != expected {
+				t.Errorf("Expected anagrams '%s' but got '%s'", expected, actual)
+			}
+		})
+
+		t.Run("returns nothing for strings with no valid anagrams", func(t *testing.T) {
+			if actual := index.Anagrams("GDOO"); len(actual) != 0 {
+				t.Errorf("Expected no anagrams but got %v", actual)
+			}
+		})
+	})
+
+	t.Run(".Contains()", func(t *testing.T) {
+
+		t.Run("returns true for anagrams of valid words", func(t *testing.T) {
+			for _, word := range []string{"TCA", "cat", "ODG", "sniatre"} {
+				if !index.Contains(word) {
+					t.Errorf("Expected '%s' to be valid", word)
+				}
+			}
+		})
+
+		t.Run("returns false for strings which aren't anagrams of valid words", func(t *testing.T) {
+			for _, word := range []string{"CATS", "DO", ""} {
+				if index.Contains(word) {
+					t.Errorf("Expected '%s' to be invalid", word)
+				}
+			}
+		})
+	})
+}
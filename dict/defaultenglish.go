@@ -1,11 +1,23 @@
 package dict
 
-import "strings"
+var defaultEnglishNormalizer = LocaleNormalizer("en", false)
 
-// DefaultEnglish validates words against a default English word list.
+// DefaultEnglish validates words against a default English word list. Words
+// are normalized using the English LocaleNormalizer before being checked.
 //
 // This dictionary is based on the public domain ENABLE word list collated by
 // Alan Beale.
 func DefaultEnglish(word string) (isValid bool) {
-	return defaultEnglishDictionaryWords[strings.ToLower(word)]
+	return defaultEnglishDictionaryWords[defaultEnglishNormalizer(word)]
+}
+
+// DefaultEnglishWordList returns the default English word list as a WordList,
+// so that its words can be enumerated (eg for searching with the query
+// package).
+func DefaultEnglishWordList() *WordList {
+	words := make([]string, 0, len(defaultEnglishDictionaryWords))
+	for w := range defaultEnglishDictionaryWords {
+		words = append(words, w)
+	}
+	return NewWordList(words, defaultEnglishNormalizer)
 }
@@ -0,0 +1,15 @@
+package dict
+
+// Normalizer represents a function which converts a word into the canonical
+// form used for dictionary lookups, so that equivalent spellings of a word
+// (eg differing in case or Unicode composition) are treated as the same word.
+type Normalizer func(word string) (normalized string)
+
+// Normalized returns a Dictionary which normalizes words using the specified
+// Normalizer before validating them against the given Dictionary. This allows
+// a dictionary of already normalized words to be used with words as played.
+func Normalized(d Dictionary, normalize Normalizer) Dictionary {
+	return func(word string) bool {
+		return d(normalize(word))
+	}
+}
@@ -0,0 +1,14 @@
+package dict
+
+import "testing"
+
+func TestNormalized(t *testing.T) {
+
+	t.Run("normalizes words before validating them", func(t *testing.T) {
+		d := Normalized(func(word string) bool { return word == "cat" }, FoldCase("en"))
+
+		if !d("CAT") {
+			t.Errorf("Expected 'CAT' to be valid")
+		}
+	})
+}
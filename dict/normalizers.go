@@ -0,0 +1,72 @@
+package dict
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// FoldCase returns a Normalizer which converts words to lower case using the
+// case mapping rules of the language with the specified locale code (eg "en",
+// "tr", or "tr-TR"). Turkish and Azerbaijani map dotted and dotless I
+// distinctly; all other languages use the standard Unicode case mapping.
+func FoldCase(localeCode string) Normalizer {
+	language := strings.ToLower(localeCode)
+	if i := strings.IndexAny(language, "-_"); i >= 0 {
+		language = language[:i]
+	}
+
+	switch language {
+	case "az", "tr":
+		return func(word string) string {
+			return strings.ToLowerSpecial(unicode.TurkishCase, word)
+		}
+	default:
+		return strings.ToLower
+	}
+}
+
+// LocaleNormalizer returns the Normalizer for words in the language with the
+// specified locale code. Words are put into Unicode Normalization Form C
+// before being case folded according to the language, so that precomposed and
+// decomposed characters fold identically, and optionally have their accents
+// stripped (for languages such as French where accents are ignored in play).
+func LocaleNormalizer(localeCode string, stripAccents bool) Normalizer {
+	if stripAccents {
+		return Normalizers(NFC, FoldCase(localeCode), StripAccents)
+	}
+	return Normalizers(NFC, FoldCase(localeCode), NFC)
+}
+
+// NFC implements a Normalizer which puts words into Unicode Normalization Form
+// C (canonical composition), so that precomposed and decomposed forms of the
+// same characters are treated identically.
+func NFC(word string) string {
+	return norm.NFC.String(word)
+}
+
+// Normalizers returns a Normalizer which applies all of the specified
+// normalizers in order.
+func Normalizers(normalizers ...Normalizer) Normalizer {
+	return func(word string) string {
+		for _, n := range normalizers {
+			word = n(word)
+		}
+		return word
+	}
+}
+
+// StripAccents implements a Normalizer which removes accents and other
+// combining marks from words (eg "élève" becomes "eleve"). The result is in
+// Unicode Normalization Form C.
+func StripAccents(word string) string {
+	stripped := strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		return r
+	}, norm.NFD.String(word))
+
+	return norm.NFC.String(stripped)
+}
@@ -0,0 +1,78 @@
+package dict
+
+import "testing"
+
+func TestFoldCase(t *testing.T) {
+
+	t.Run("uses standard case mapping by default", func(t *testing.T) {
+		if actual, expected := FoldCase("en")("QUIZ"), "quiz"; actual != expected {
+			t.Errorf("Expected '%s' but got '%s'", expected, actual)
+		}
+	})
+
+	t.Run("maps dotted and dotless I distinctly for Turkish", func(t *testing.T) {
+		for _, code := range []string{"tr", "TR", "tr-TR", "tr_TR", "az"} {
+			if actual, expected := FoldCase(code)("IŞIK İZ"), "ışık iz"; actual != expected {
+				t.Errorf("Expected '%s' for %s but got '%s'", expected, code, actual)
+			}
+		}
+	})
+}
+
+func TestLocaleNormalizer(t *testing.T) {
+
+	t.Run("folds case and composes characters", func(t *testing.T) {
+		if actual, expected := LocaleNormalizer("es", false)("ÑAÑDU"), "ñañdu"; actual != expected {
+			t.Errorf("Expected '%s' but got '%s'", expected, actual)
+		}
+	})
+
+	t.Run("folds decomposed characters the same as precomposed ones", func(t *testing.T) {
+		normalize := LocaleNormalizer("tr", false)
+
+		if actual, expected := normalize("I\u0307Z"), normalize("\u0130Z"); actual != expected {
+			t.Errorf("Expected '%s' but got '%s'", expected, actual)
+		}
+		if actual, expected := normalize("I\u0307Z"), "iz"; actual != expected {
+			t.Errorf("Expected '%s' but got '%s'", expected, actual)
+		}
+	})
+
+	t.Run("optionally strips accents", func(t *testing.T) {
+		if actual, expected := LocaleNormalizer("fr", true)("ÉLÈVE"), "eleve"; actual != expected {
+			t.Errorf("Expected '%s' but got '%s'", expected, actual)
+		}
+	})
+}
+
+func TestNFC(t *testing.T) {
+
+	t.Run("composes decomposed characters", func(t *testing.T) {
+		if actual, expected := NFC("é"), "é"; actual != expected {
+			t.Errorf("Expected '%s' but got '%s'", expected, actual)
+		}
+	})
+}
+
+func TestNormalizers(t *testing.T) {
+
+	t.Run("applies normalizers in order", func(t *testing.T) {
+		n := Normalizers(
+			func(w string) string { return w + "a" },
+			func(w string) string { return w + "b" },
+		)
+
+		if actual, expected := n("x"), "xab"; actual != expected {
+			t.Errorf("Expected '%s' but got '%s'", expected, actual)
+		}
+	})
+}
+
+func TestStripAccents(t *testing.T) {
+
+	t.Run("removes accents from precomposed and decomposed characters", func(t *testing.T) {
+		if actual, expected := StripAccents("crème brûlée"), "creme brulee"; actual != expected {
+			t.Errorf("Expected '%s' but got '%s'", expected, actual)
+		}
+	})
+}
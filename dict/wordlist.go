@@ -0,0 +1,99 @@
+package dict
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strings"
+)
+
+// WordList is a set of valid words which can be used as a Dictionary (via the
+// Contains method). Words are normalized when the list is built and when they
+// are looked up, so that lookups are consistent with the list's contents.
+type WordList struct {
+	normalize Normalizer
+	words     map[string]bool
+	letters   []string
+}
+
+// LoadWordList reads a WordList from the specified reader, which should contain
+// one word per line. Blank lines are ignored. Words are normalized using the
+// given Normalizer, as with NewWordList.
+func LoadWordList(r io.Reader, normalize Normalizer) (*WordList, error) {
+	var words []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if word := strings.TrimSpace(scanner.Text()); word != "" {
+			words = append(words, word)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewWordList(words, normalize), nil
+}
+
+// NewWordList returns a WordList containing the specified words, which are
+// normalized using the given Normalizer. If the Normalizer is nil, words are
+// used as is.
+func NewWordList(words []string, normalize Normalizer) *WordList {
+	if normalize == nil {
+		normalize = func(word string) string { return word }
+	}
+
+	wl := &WordList{
+		normalize: normalize,
+		words:     make(map[string]bool, len(words)),
+	}
+	seen := make(map[rune]bool)
+	for _, w := range words {
+		w = normalize(w)
+		wl.words[w] = true
+
+		for _, r := range w {
+			if !seen[r] {
+				seen[r] = true
+				wl.letters = append(wl.letters, string(r))
+			}
+		}
+	}
+	sort.Strings(wl.letters)
+
+	return wl
+}
+
+// Contains returns whether the specified word, once normalized, is in this
+// list. This satisfies the Dictionary function type, and so can be used
+// wherever a Dictionary is needed (eg game.Rules.WithDictionary(wl.Contains)).
+func (wl *WordList) Contains(word string) (valid bool) {
+	return wl.words[wl.normalize(word)]
+}
+
+// Len returns the number of distinct (normalized) words in this list.
+func (wl *WordList) Len() int {
+	return len(wl.words)
+}
+
+// Letters returns the distinct letters (characters) used by the words in this
+// list, in sorted order.
+func (wl *WordList) Letters() []string {
+	return wl.letters
+}
+
+// Normalize returns the specified word as normalized by this list, so that it
+// can be compared with the list's words.
+func (wl *WordList) Normalize(word string) string {
+	return wl.normalize(word)
+}
+
+// Words returns all of the (normalized) words in this list, in sorted order.
+func (wl *WordList) Words() []string {
+	words := make([]string, 0, len(wl.words))
+	for w := range wl.words {
+		words = append(words, w)
+	}
+	sort.Strings(words)
+	return words
+}
@@ -0,0 +1,111 @@
+package dict
+
+import (
+	"strings"
+	"testing"
+)
+
+func expectWords(t *testing.T, words []string, expected ...string) {
+	t.Helper()
+
+	if actual, expectedLen := len(words), len(expected); actual != expectedLen {
+		t.Errorf("Expected %d words but found %d: %v", expectedLen, actual, words)
+
+	} else {
+		for i, e := range expected {
+			if words[i] != e {
+				t.Errorf("Expected word '%s' in position %d but found '%s'", e, i, words[i])
+			}
+		}
+	}
+}
+
+func TestLoadWordList(t *testing.T) {
+
+	t.Run("reads one word per line, ignoring blank lines", func(t *testing.T) {
+		wl, err := LoadWordList(strings.NewReader("Cat\n\n  dog \nCAT\n"), FoldCase("en"))
+
+		if err != nil {
+			t.Fatalf("Expected success but got error %v", err)
+		}
+		expectWords(t, wl.Words(), "cat", "dog")
+	})
+}
+
+func TestWordList(t *testing.T) {
+
+	t.Run(".Contains()", func(t *testing.T) {
+
+		t.Run("normalizes words when building the list and looking them up", func(t *testing.T) {
+			wl := NewWordList([]string{"Kahve", "IŞIK"}, LocaleNormalizer("tr", false))
+
+			for _, word := range []string{"KAHVE", "kahve", "ışık", "IŞIK"} {
+				if !wl.Contains(word) {
+					t.Errorf("Expected '%s' to be in the list", word)
+				}
+			}
+			if wl.Contains("isik") {
+				t.Errorf("Expected 'isik' not to be in the list")
+			}
+		})
+
+		t.Run("uses words as is without a normalizer", func(t *testing.T) {
+			wl := NewWordList([]string{"cat"}, nil)
+
+			if !wl.Contains("cat") {
+				t.Errorf("Expected 'cat' to be in the list")
+			}
+			if wl.Contains("CAT") {
+				t.Errorf("Expected 'CAT' not to be in the list")
+			}
+		})
+
+		t.Run("can be used as a Dictionary", func(t *testing.T) {
+			var d Dictionary = NewWordList([]string{"cat"}, nil).Contains
+
+			if !d("cat") {
+				t.Errorf("Expected 'cat' to be valid")
+			}
+		})
+	})
+
+	t.Run(".Len()", func(t *testing.T) {
+
+		t.Run("returns the number of distinct normalized words", func(t *testing.T) {
+			wl := NewWordList([]string{"cat", "CAT", "dog"}, FoldCase("en"))
+
+			if actual, expected := wl.Len(), 2; actual != expected {
+				t.Errorf("Expected %d words but found %d", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".Letters()", func(t *testing.T) {
+
+		t.Run("returns the distinct letters used by the words", func(t *testing.T) {
+			wl := NewWordList([]string{"Cab", "bad"}, FoldCase("en"))
+
+			expectWords(t, wl.Letters(), "a", "b", "c", "d")
+		})
+	})
+
+	t.Run(".Normalize()", func(t *testing.T) {
+
+		t.Run("normalizes words the same way as the list", func(t *testing.T) {
+			wl := NewWordList(nil, FoldCase("tr"))
+
+			if actual, expected := wl.Normalize("KIZ"), "kız"; actual != expected {
+				t.Errorf("Expected '%s' but got '%s'", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".Words()", func(t *testing.T) {
+
+		t.Run("returns normalized words in sorted order", func(t *testing.T) {
+			wl := NewWordList([]string{"Zebra", "apple", "Mango"}, FoldCase("en"))
+
+			expectWords(t, wl.Words(), "apple", "mango", "zebra")
+		})
+	})
+}
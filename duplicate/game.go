@@ -0,0 +1,201 @@
+package duplicate
+
+import (
+	"math/rand"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/game"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// Game represents a game played in duplicate mode. Every player gets the same
+// rack each round and submits a sealed play for it. When the round is closed,
+// all submissions are scored and the top scoring play (the master move) is
+// applied to the shared board, before the rack is replenished for the next
+// round.
+//
+// Players' cumulative scores are kept along with the maximum score, which is
+// the total of the master moves' scores. This is only the maximum achievable
+// score if FindMove is set; otherwise, it is the total of the best
+// submissions.
+type Game struct {
+	Phase    game.Phase
+	Board    board.Board
+	Bag      tile.Bag
+	Rack     tile.Rack
+	Rules    game.Rules
+	Scores   []int
+	MaxScore int
+	Rounds   []Round
+
+	// FindMove optionally finds the master move for each round, which is used
+	// if it outscores all of the players' submissions. Without it, MaxScore
+	// only reflects the best submissions.
+	FindMove MoveFinder
+
+	submissions []play.Tiles
+	submitted   []bool
+}
+
+// New returns a duplicate game in the SetupPhase for the specified number of
+// players, using the given bag, board, and rules.
+//
+// Since duplicate games have no challenges, the rules are always set to
+// validate words against the dictionary when scoring.
+func New(bag tile.Bag, b board.Board, rules game.Rules, players int) *Game {
+	return &Game{
+		Board:       b,
+		Bag:         bag,
+		Rules:       rules.WithDictionaryForScoring(true),
+		Scores:      make([]int, players),
+		submissions: make([]play.Tiles, players),
+		submitted:   make([]bool, players),
+	}
+}
+
+// CloseRound scores every player's submission for the current round (with
+// players who haven't submitted treated as passing), and applies the master
+// move to the board. The master move is the highest scoring submission (with
+// ties going to the lowest player index), or the move found by FindMove if it
+// scores higher. Submissions which aren't legal plays from the rack are
+// rejected, scoring nothing, and are recorded with the reason in the round.
+//
+// The rack is then replenished from the bag for the next round. If there was
+// no valid master move, or the rack can't be replenished, the game moves into
+// the EndPhase.
+//
+// If the master move's tiles can't be taken from the rack, the error is
+// returned and the round is left open.
+//
+// If the game is not in the Main phase, game.OutOfPhaseError is returned.
+func (g *Game) CloseRound() (round Round, err error) {
+	if g.Phase != game.MainPhase {
+		return round, game.OutOfPhaseError{Required: game.MainPhase, Current: g.Phase}
+	}
+
+	round.Rack = append(tile.Rack{}, g.Rack...)
+	round.Master.Player = MasterPlayer
+
+	for i := range g.Scores {
+		s := g.score(i, g.submissions[i])
+		round.Submissions = append(round.Submissions, s)
+
+		if s.Err == nil && len(s.Placements) > 0 && (len(round.Master.Placements) == 0 || s.Score > round.Master.Score) {
+			round.Master = s
+		}
+	}
+
+	if g.FindMove != nil {
+		if placements, found := g.FindMove(&g.Board, g.Rack); found {
+			s := g.score(MasterPlayer, placements)
+			if s.Err == nil && (len(round.Master.Placements) == 0 || s.Score > round.Master.Score) {
+				round.Master = s
+			}
+		}
+	}
+
+	var remaining []tile.Tile
+	if len(round.Master.Placements) > 0 {
+		if _, remaining, err = g.Rules.ValidateTilesFromRack(g.Rack, round.Master.Placements.Tiles()); err != nil {
+			return Round{}, err
+		}
+	}
+
+	for i, s := range round.Submissions {
+		g.Scores[i] += s.Score
+		g.submissions[i] = nil
+		g.submitted[i] = false
+	}
+	g.Rounds = append(g.Rounds, round)
+
+	if len(round.Master.Placements) == 0 {
+		g.Phase = game.EndPhase
+		return
+	}
+
+	g.Rack = remaining
+	round.Master.Placements.Place(&g.Board)
+	g.MaxScore += round.Master.Score
+
+	g.Rack.FillFromBagTo(&g.Bag, g.Rules.RackSize())
+	if len(g.Rack) == 0 {
+		g.Phase = game.EndPhase
+	}
+
+	return
+}
+
+// Start begins the game by shuffling the bag and drawing the first rack, and
+// moves the game into the MainPhase.
+//
+// If the game has no players, game.NotEnoughPlayersError is returned.
+//
+// If the game is not in the Setup phase, game.OutOfPhaseError is returned.
+func (g *Game) Start(r *rand.Rand) error {
+	if g.Phase != game.SetupPhase {
+		return game.OutOfPhaseError{Required: game.SetupPhase, Current: g.Phase}
+	}
+	if len(g.Scores) < game.MinPlayers {
+		return game.NotEnoughPlayersError{Required: game.MinPlayers, Current: len(g.Scores)}
+	}
+
+	g.Bag.Shuffle(r)
+	g.Rack.FillFromBagTo(&g.Bag, g.Rules.RackSize())
+	g.Phase = game.MainPhase
+
+	return nil
+}
+
+// Submit records the specified player's sealed play for the current round,
+// replacing any play they have already submitted. Submissions aren't checked
+// until the round is closed. Submitting no placements is a pass.
+//
+// If there is no such player, UnknownPlayerError is returned.
+//
+// If the game is not in the Main phase, game.OutOfPhaseError is returned.
+func (g *Game) Submit(player int, placements play.Tiles) error {
+	if g.Phase != game.MainPhase {
+		return game.OutOfPhaseError{Required: game.MainPhase, Current: g.Phase}
+	}
+	if player < 0 || player >= len(g.Scores) {
+		return UnknownPlayerError{Player: player}
+	}
+
+	g.submissions[player] = append(play.Tiles{}, placements...)
+	g.submitted[player] = true
+
+	return nil
+}
+
+// Submitted returns whether the specified player has submitted a play for the
+// current round.
+func (g *Game) Submitted(player int) bool {
+	return player >= 0 && player < len(g.submitted) && g.submitted[player]
+}
+
+func (g *Game) score(player int, placements play.Tiles) (s Submission) {
+	s.Player = player
+	s.Placements = placements
+
+	if len(placements) == 0 {
+		return
+	}
+
+	if _, _, s.Err = g.Rules.ValidateTilesFromRack(g.Rack, placements.Tiles()); s.Err != nil {
+		return
+	}
+	if s.Err = g.Rules.ValidatePlacements(placements, &g.Board); s.Err != nil {
+		return
+	}
+
+	score, words, err := g.Rules.ScoreWords(placements, &g.Board)
+	if err != nil {
+		s.Err = err
+		return
+	}
+
+	s.Score = score
+	s.Words = words
+	return
+}
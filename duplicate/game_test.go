@@ -0,0 +1,221 @@
+package duplicate
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/dict"
+	"github.com/mandykoh/scrubble/game"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func TestGame(t *testing.T) {
+	words := dict.NewWordList([]string{"cab", "bad", "dab", "abed"}, dict.FoldCase("en"))
+	rules := game.Rules{}.WithDictionary(words.Contains)
+
+	a, b, c, d, e := tile.Make('A', 1), tile.Make('B', 3), tile.Make('C', 3), tile.Make('D', 2), tile.Make('E', 1)
+
+	across := func(row, col int, tiles ...tile.Tile) (placements play.Tiles) {
+		for i, t := range tiles {
+			placements = append(placements, play.TilePlacement{Tile: t, Coord: coord.Make(row, col+i)})
+		}
+		return
+	}
+
+	setupGame := func(players int) *Game {
+		g := New(tile.Bag{tile.Make('X', 8), tile.Make('Y', 4), tile.Make('Z', 10)}, board.WithStandardLayout(), rules, players)
+		g.Phase = game.MainPhase
+		g.Rack = tile.Rack{a, b, c, d, e}
+		return g
+	}
+
+	t.Run(".Start()", func(t *testing.T) {
+
+		t.Run("draws the shared rack and starts the game", func(t *testing.T) {
+			g := New(tile.BagWithStandardEnglishTiles(), board.WithStandardLayout(), rules, 2)
+
+			if err := g.Start(rand.New(rand.NewSource(1))); err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if actual, expected := len(g.Rack), tile.MaxRackTiles; actual != expected {
+				t.Errorf("Expected rack of %d tiles but got %d", expected, actual)
+			}
+			if actual, expected := g.Phase, game.MainPhase; actual != expected {
+				t.Errorf("Expected game to be in %s phase but was %s", expected, actual)
+			}
+		})
+
+		t.Run("returns an error if there are no players", func(t *testing.T) {
+			g := New(tile.BagWithStandardEnglishTiles(), board.WithStandardLayout(), rules, 0)
+
+			err := g.Start(rand.New(rand.NewSource(1)))
+
+			if actual, expected := err, (game.NotEnoughPlayersError{Required: game.MinPlayers, Current: 0}); actual != expected {
+				t.Errorf("Expected error %v but got %v", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".Submit()", func(t *testing.T) {
+
+		t.Run("records a sealed submission", func(t *testing.T) {
+			g := setupGame(2)
+
+			if err := g.Submit(1, across(7, 7, c, a, b)); err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if !g.Submitted(1) || g.Submitted(0) {
+				t.Errorf("Expected only player 1 to have submitted")
+			}
+			if !g.Board.IsEmpty() {
+				t.Errorf("Expected submission not to be placed on the board")
+			}
+		})
+
+		t.Run("returns an error for unknown players", func(t *testing.T) {
+			g := setupGame(2)
+
+			if actual, expected := g.Submit(2, nil), (UnknownPlayerError{Player: 2}); actual != expected {
+				t.Errorf("Expected error %v but got %v", expected, actual)
+			}
+		})
+
+		t.Run("returns an error when the game is not in the Main phase", func(t *testing.T) {
+			g := setupGame(2)
+			g.Phase = game.EndPhase
+
+			if actual, expected := g.Submit(0, nil), (game.OutOfPhaseError{Required: game.MainPhase, Current: game.EndPhase}); actual != expected {
+				t.Errorf("Expected error %v but got %v", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".CloseRound()", func(t *testing.T) {
+
+		t.Run("scores all submissions and applies the top one", func(t *testing.T) {
+			g := setupGame(4)
+			g.Submit(0, across(7, 7, b, a, d))
+			g.Submit(1, across(7, 6, c, a, b))
+			g.Submit(2, across(7, 7, b, c, d))
+
+			round, err := g.CloseRound()
+
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+
+			for i, expected := range []int{12, 14, 0, 0} {
+				if actual := g.Scores[i]; actual != expected {
+					t.Errorf("Expected player %d to have score %d but got %d", i, expected, actual)
+				}
+			}
+			if _, ok := round.Submissions[2].Err.(play.InvalidWordError); !ok {
+				t.Errorf("Expected invalid word error for player 2 but got %v", round.Submissions[2].Err)
+			}
+			if actual, expected := round.Master.Player, 1; actual != expected {
+				t.Errorf("Expected master move from player %d but got %d", expected, actual)
+			}
+			if actual, expected := g.MaxScore, 14; actual != expected {
+				t.Errorf("Expected maximum score %d but got %d", expected, actual)
+			}
+			if g.Board.Position(coord.Make(7, 6)).Tile == nil {
+				t.Errorf("Expected master move to be placed on the board")
+			}
+			if actual, expected := len(g.Rack), 5; actual != expected {
+				t.Errorf("Expected rack to be replenished to %d tiles but has %d", expected, actual)
+			}
+			if g.Submitted(1) {
+				t.Errorf("Expected submissions to be cleared for the next round")
+			}
+			if actual, expected := len(g.Rounds), 1; actual != expected {
+				t.Errorf("Expected %d rounds but got %d", expected, actual)
+			}
+		})
+
+		t.Run("rejects submissions with tiles not on the rack", func(t *testing.T) {
+			g := setupGame(2)
+			g.Submit(0, across(7, 7, b, a, d, a))
+			g.Submit(1, across(7, 7, b, a, d))
+
+			round, err := g.CloseRound()
+
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if round.Submissions[0].Err == nil {
+				t.Errorf("Expected an error for player 0's submission")
+			}
+			if actual, expected := g.Scores[0], 0; actual != expected {
+				t.Errorf("Expected player 0 to have score %d but got %d", expected, actual)
+			}
+			if actual, expected := round.Master.Player, 1; actual != expected {
+				t.Errorf("Expected master move from player %d but got %d", expected, actual)
+			}
+		})
+
+		t.Run("returns an error and leaves the round open if the master move can't be taken from the rack", func(t *testing.T) {
+			g := setupGame(1)
+			calls := 0
+			g.Rules = g.Rules.WithRackValidator(func(rack tile.Rack, toPlay []tile.Tile) ([]tile.Tile, []tile.Tile, error) {
+				calls++
+				if calls > 1 {
+					return nil, nil, tile.InsufficientTilesError{}
+				}
+				return tile.ValidateFromRack(rack, toPlay)
+			})
+			g.Submit(0, across(7, 7, b, a, d))
+
+			_, err := g.CloseRound()
+
+			if _, ok := err.(tile.InsufficientTilesError); !ok {
+				t.Errorf("Expected insufficient tiles error but got %v", err)
+			}
+			if actual, expected := g.Scores[0], 0; actual != expected {
+				t.Errorf("Expected player score %d but got %d", expected, actual)
+			}
+			if !g.Submitted(0) {
+				t.Errorf("Expected submission to be kept")
+			}
+			if !g.Board.IsEmpty() {
+				t.Errorf("Expected board to be left empty")
+			}
+		})
+
+		t.Run("uses the found move if it outscores all submissions", func(t *testing.T) {
+			g := setupGame(1)
+			g.FindMove = func(*board.Board, tile.Rack) (play.Tiles, bool) {
+				return across(7, 7, a, b, e, d), true
+			}
+			g.Submit(0, across(7, 7, b, a, d))
+
+			round, _ := g.CloseRound()
+
+			if actual, expected := round.Master.Player, MasterPlayer; actual != expected {
+				t.Errorf("Expected master move from player %d but got %d", expected, actual)
+			}
+			if actual, expected := g.MaxScore, 14; actual != expected {
+				t.Errorf("Expected maximum score %d but got %d", expected, actual)
+			}
+			if actual, expected := g.Scores[0], 12; actual != expected {
+				t.Errorf("Expected player score %d but got %d", expected, actual)
+			}
+		})
+
+		t.Run("ends the game when there is no valid master move", func(t *testing.T) {
+			g := setupGame(2)
+			g.Submit(0, across(7, 7, b, c, d))
+
+			g.CloseRound()
+
+			if actual, expected := g.Phase, game.EndPhase; actual != expected {
+				t.Errorf("Expected game to be in %s phase but was %s", expected, actual)
+			}
+			if !g.Board.IsEmpty() {
+				t.Errorf("Expected board to be left empty")
+			}
+		})
+	})
+}
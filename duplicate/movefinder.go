@@ -0,0 +1,25 @@
+package duplicate
+
+import (
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/movegen"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// MoveFinder represents a function which finds the highest scoring play for a
+// rack on a board, for use as the master move of a round if no player submits
+// a better one. If no play can be made, found should be false.
+type MoveFinder func(b *board.Board, rack tile.Rack) (placements play.Tiles, found bool)
+
+// GeneratorMoveFinder returns a MoveFinder which uses the highest scoring play
+// found by the specified move generator.
+func GeneratorMoveFinder(gen *movegen.Generator) MoveFinder {
+	return func(b *board.Board, rack tile.Rack) (play.Tiles, bool) {
+		moves := gen.Moves(b, rack, nil)
+		if len(moves) == 0 {
+			return nil, false
+		}
+		return moves[0].Placements, true
+	}
+}
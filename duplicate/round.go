@@ -0,0 +1,15 @@
+package duplicate
+
+import "github.com/mandykoh/scrubble/tile"
+
+// Round represents a completed round of a duplicate game: the rack shared by
+// all players, each player's scored submission (by player index), and the
+// master move which was applied to the board.
+//
+// If no valid play was found for the round, Master has no placements and the
+// game ends.
+type Round struct {
+	Rack        tile.Rack
+	Submissions []Submission
+	Master      Submission
+}
@@ -0,0 +1,20 @@
+package duplicate
+
+import "github.com/mandykoh/scrubble/play"
+
+// MasterPlayer is the player index recorded for a master move which was found
+// by a MoveFinder rather than submitted by a player.
+const MasterPlayer = -1
+
+// Submission represents a play submitted for a round, along with its score
+// once the round is closed.
+//
+// A submission with no placements is a pass. Submissions which aren't valid
+// plays score nothing, and have the error explaining why recorded in Err.
+type Submission struct {
+	Player     int
+	Placements play.Tiles
+	Score      int
+	Words      []play.Word
+	Err        error
+}
@@ -0,0 +1,13 @@
+package duplicate
+
+import "fmt"
+
+// UnknownPlayerError indicates that a submission was made for a player index
+// which doesn't exist in the game.
+type UnknownPlayerError struct {
+	Player int
+}
+
+func (e UnknownPlayerError) Error() string {
+	return fmt.Sprintf("%#v", e)
+}
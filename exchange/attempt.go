@@ -0,0 +1,25 @@
+package exchange
+
+import "github.com/mandykoh/scrubble/tile"
+
+// Attempt describes an attempt by a player to exchange tiles with the bag, for
+// the purposes of validation.
+type Attempt struct {
+
+	// Tiles are the tiles the player wants to exchange.
+	Tiles []tile.Tile
+
+	// BagTiles is the number of tiles remaining in the bag.
+	BagTiles int
+
+	// RackSize is the number of tiles that each player's rack is filled to
+	// (see game.Rules.RackSize).
+	RackSize int
+
+	// Score is the player's current score.
+	Score int
+
+	// PreviousExchanges is the number of exchanges the player has already made
+	// during the game.
+	PreviousExchanges int
+}
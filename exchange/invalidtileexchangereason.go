@@ -10,6 +10,18 @@ const (
 	// InsufficientTilesInBagReason indicates that an exchange was attempted to
 	// when the bag did not contain enough tiles.
 	InsufficientTilesInBagReason
+
+	// WrongTileCountReason indicates that an exchange was attempted with a
+	// number of tiles other than the number required.
+	WrongTileCountReason
+
+	// ExchangeLimitReachedReason indicates that an exchange was attempted by a
+	// player who had already made the maximum number of exchanges allowed.
+	ExchangeLimitReachedReason
+
+	// InsufficientScoreReason indicates that an exchange was attempted by a
+	// player whose score was less than the minimum required to exchange.
+	InsufficientScoreReason
 )
 
 // InvalidTileExchangeReason indicates the reason for an
@@ -24,6 +36,12 @@ func (r InvalidTileExchangeReason) GoString() string {
 		return "NoTilesExchangedReason"
 	case InsufficientTilesInBagReason:
 		return "InsufficientTilesInBagReason"
+	case WrongTileCountReason:
+		return "WrongTileCountReason"
+	case ExchangeLimitReachedReason:
+		return "ExchangeLimitReachedReason"
+	case InsufficientScoreReason:
+		return "InsufficientScoreReason"
 	default:
 		return "UnknownInvalidTileExchangeReason"
 	}
@@ -37,6 +55,12 @@ func (r InvalidTileExchangeReason) String() string {
 		return "NoTilesExchanged"
 	case InsufficientTilesInBagReason:
 		return "InsufficientTilesInBag"
+	case WrongTileCountReason:
+		return "WrongTileCount"
+	case ExchangeLimitReachedReason:
+		return "ExchangeLimitReached"
+	case InsufficientScoreReason:
+		return "InsufficientScore"
 	default:
 		return "Unknown"
 	}
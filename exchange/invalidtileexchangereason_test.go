@@ -13,6 +13,9 @@ func TestInvalidTileExchangeReason(t *testing.T) {
 			}{
 				{NoTilesExchangedReason, "NoTilesExchangedReason"},
 				{InsufficientTilesInBagReason, "InsufficientTilesInBagReason"},
+				{WrongTileCountReason, "WrongTileCountReason"},
+				{ExchangeLimitReachedReason, "ExchangeLimitReachedReason"},
+				{InsufficientScoreReason, "InsufficientScoreReason"},
 				{UnknownInvalidTileExchangeReason, "UnknownInvalidTileExchangeReason"},
 			}
 
@@ -43,6 +46,9 @@ func TestInvalidTileExchangeReason(t *testing.T) {
 			}{
 				{NoTilesExchangedReason, "NoTilesExchanged"},
 				{InsufficientTilesInBagReason, "InsufficientTilesInBag"},
+				{WrongTileCountReason, "WrongTileCount"},
+				{ExchangeLimitReachedReason, "ExchangeLimitReached"},
+				{InsufficientScoreReason, "InsufficientScore"},
 			}
 
 			for _, c := range cases {
@@ -0,0 +1,94 @@
+package exchange
+
+// All returns a Validator which requires an exchange to satisfy all of the
+// specified validators. Validators are checked in order, and the first
+// violation found is returned. The cost of the exchange is the total of the
+// costs from all of the validators.
+func All(validators ...Validator) Validator {
+	return func(attempt Attempt) (cost int, err error) {
+		for _, v := range validators {
+			c, err := v(attempt)
+			if err != nil {
+				return 0, err
+			}
+			cost += c
+		}
+		return cost, nil
+	}
+}
+
+// Cost returns a Validator which charges the specified number of points for
+// each exchange. The cost is deducted even if it leaves the player with a
+// negative score; to prevent this, combine it with MinScore.
+func Cost(points int) Validator {
+	return func(attempt Attempt) (int, error) {
+		return points, nil
+	}
+}
+
+// MaxExchanges returns a Validator which limits the number of exchanges each
+// player can make during a game.
+//
+// If the player has already made that many exchanges, InvalidTileExchangeError
+// is returned with a reason of ExchangeLimitReachedReason.
+func MaxExchanges(count int) Validator {
+	return func(attempt Attempt) (int, error) {
+		if attempt.PreviousExchanges >= count {
+			return 0, InvalidTileExchangeError{ExchangeLimitReachedReason}
+		}
+		return 0, nil
+	}
+}
+
+// MinBagTiles returns a Validator which only allows exchanges while the bag
+// holds at least the specified number of tiles.
+//
+// If there are fewer tiles in the bag, InvalidTileExchangeError is returned
+// with a reason of InsufficientTilesInBagReason.
+func MinBagTiles(count int) Validator {
+	return func(attempt Attempt) (int, error) {
+		if attempt.BagTiles < count {
+			return 0, InvalidTileExchangeError{InsufficientTilesInBagReason}
+		}
+		return 0, nil
+	}
+}
+
+// MinScore returns a Validator which only allows exchanges by players with a
+// score of at least the specified number of points (eg the cost of the
+// exchange, so that it can't leave the player with a negative score).
+//
+// If the player's score is lower, InvalidTileExchangeError is returned with a
+// reason of InsufficientScoreReason.
+func MinScore(points int) Validator {
+	return func(attempt Attempt) (int, error) {
+		if attempt.Score < points {
+			return 0, InvalidTileExchangeError{InsufficientScoreReason}
+		}
+		return 0, nil
+	}
+}
+
+// TileCount returns a Validator which only allows exchanges of exactly the
+// specified number of tiles.
+//
+// If a different number of tiles is exchanged, InvalidTileExchangeError is
+// returned with a reason of WrongTileCountReason.
+func TileCount(count int) Validator {
+	return func(attempt Attempt) (int, error) {
+		if len(attempt.Tiles) != count {
+			return 0, InvalidTileExchangeError{WrongTileCountReason}
+		}
+		return 0, nil
+	}
+}
+
+// Validate implements the default Validator, which allows exchanges at no cost
+// while the bag holds at least a full rack of tiles (as given by the attempt's
+// RackSize).
+//
+// If there are fewer tiles in the bag, InvalidTileExchangeError is returned
+// with a reason of InsufficientTilesInBagReason.
+func Validate(attempt Attempt) (cost int, err error) {
+	return MinBagTiles(attempt.RackSize)(attempt)
+}
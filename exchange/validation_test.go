@@ -0,0 +1,154 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func TestAll(t *testing.T) {
+
+	t.Run("returns the total cost when all validators pass", func(t *testing.T) {
+		validate := All(Cost(5), MinBagTiles(1), Cost(3))
+
+		cost, err := validate(Attempt{BagTiles: 1, Score: 10})
+
+		if err != nil {
+			t.Fatalf("Expected no error but got %v", err)
+		}
+		if actual, expected := cost, 8; actual != expected {
+			t.Errorf("Expected cost of %d but got %d", expected, actual)
+		}
+	})
+
+	t.Run("returns the first violation", func(t *testing.T) {
+		validate := All(Cost(5), MaxExchanges(1), MinBagTiles(7))
+
+		cost, err := validate(Attempt{BagTiles: 0, Score: 10, PreviousExchanges: 1})
+
+		if actual, expected := err, (InvalidTileExchangeError{ExchangeLimitReachedReason}); actual != expected {
+			t.Errorf("Expected error %v but got %v", expected, actual)
+		}
+		if actual, expected := cost, 0; actual != expected {
+			t.Errorf("Expected cost of %d but got %d", expected, actual)
+		}
+	})
+}
+
+func TestCost(t *testing.T) {
+
+	t.Run("returns the cost of the exchange", func(t *testing.T) {
+		cost, err := Cost(10)(Attempt{Score: 10})
+
+		if err != nil {
+			t.Fatalf("Expected no error but got %v", err)
+		}
+		if actual, expected := cost, 10; actual != expected {
+			t.Errorf("Expected cost of %d but got %d", expected, actual)
+		}
+	})
+
+	t.Run("allows the cost to exceed the player's score", func(t *testing.T) {
+		cost, err := Cost(10)(Attempt{Score: 0})
+
+		if err != nil {
+			t.Fatalf("Expected no error but got %v", err)
+		}
+		if actual, expected := cost, 10; actual != expected {
+			t.Errorf("Expected cost of %d but got %d", expected, actual)
+		}
+	})
+}
+
+func TestMaxExchanges(t *testing.T) {
+
+	t.Run("allows exchanges until the limit is reached", func(t *testing.T) {
+		validate := MaxExchanges(2)
+
+		if _, err := validate(Attempt{PreviousExchanges: 1}); err != nil {
+			t.Errorf("Expected no error but got %v", err)
+		}
+
+		_, err := validate(Attempt{PreviousExchanges: 2})
+
+		if actual, expected := err, (InvalidTileExchangeError{ExchangeLimitReachedReason}); actual != expected {
+			t.Errorf("Expected error %v but got %v", expected, actual)
+		}
+	})
+}
+
+func TestMinBagTiles(t *testing.T) {
+
+	t.Run("allows exchanges while the bag holds enough tiles", func(t *testing.T) {
+		validate := MinBagTiles(3)
+
+		if _, err := validate(Attempt{BagTiles: 3}); err != nil {
+			t.Errorf("Expected no error but got %v", err)
+		}
+
+		_, err := validate(Attempt{BagTiles: 2})
+
+		if actual, expected := err, (InvalidTileExchangeError{InsufficientTilesInBagReason}); actual != expected {
+			t.Errorf("Expected error %v but got %v", expected, actual)
+		}
+	})
+}
+
+func TestMinScore(t *testing.T) {
+
+	t.Run("allows exchanges when the player's score is at least the minimum", func(t *testing.T) {
+		cost, err := MinScore(10)(Attempt{Score: 10})
+
+		if err != nil {
+			t.Fatalf("Expected no error but got %v", err)
+		}
+		if actual, expected := cost, 0; actual != expected {
+			t.Errorf("Expected cost of %d but got %d", expected, actual)
+		}
+	})
+
+	t.Run("returns an error when the player's score is less than the minimum", func(t *testing.T) {
+		_, err := MinScore(10)(Attempt{Score: 9})
+
+		if actual, expected := err, (InvalidTileExchangeError{InsufficientScoreReason}); actual != expected {
+			t.Errorf("Expected error %v but got %v", expected, actual)
+		}
+	})
+}
+
+func TestTileCount(t *testing.T) {
+
+	t.Run("allows exchanges of exactly the specified number of tiles", func(t *testing.T) {
+		validate := TileCount(2)
+
+		if _, err := validate(Attempt{Tiles: []tile.Tile{tile.Make('A', 1), tile.Make('B', 3)}}); err != nil {
+			t.Errorf("Expected no error but got %v", err)
+		}
+
+		_, err := validate(Attempt{Tiles: []tile.Tile{tile.Make('A', 1)}})
+
+		if actual, expected := err, (InvalidTileExchangeError{WrongTileCountReason}); actual != expected {
+			t.Errorf("Expected error %v but got %v", expected, actual)
+		}
+	})
+}
+
+func TestValidate(t *testing.T) {
+
+	t.Run("allows free exchanges while the bag holds a full rack of tiles", func(t *testing.T) {
+		cost, err := Validate(Attempt{BagTiles: 8, RackSize: 8})
+
+		if err != nil {
+			t.Fatalf("Expected no error but got %v", err)
+		}
+		if actual, expected := cost, 0; actual != expected {
+			t.Errorf("Expected cost of %d but got %d", expected, actual)
+		}
+
+		_, err = Validate(Attempt{BagTiles: 7, RackSize: 8})
+
+		if actual, expected := err, (InvalidTileExchangeError{InsufficientTilesInBagReason}); actual != expected {
+			t.Errorf("Expected error %v but got %v", expected, actual)
+		}
+	})
+}
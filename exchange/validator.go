@@ -0,0 +1,5 @@
+package exchange
+
+// Validator represents a function which determines whether an attempt to
+// exchange tiles is legal, and how many points it costs the player.
+type Validator func(attempt Attempt) (cost int, err error)
@@ -4,9 +4,12 @@ import (
 	"math/rand"
 
 	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/challenge"
+	"github.com/mandykoh/scrubble/dict"
 	"github.com/mandykoh/scrubble/exchange"
 	"github.com/mandykoh/scrubble/history"
 	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/scoring"
 	"github.com/mandykoh/scrubble/seat"
 	"github.com/mandykoh/scrubble/tile"
 )
@@ -29,6 +32,8 @@ type Game struct {
 	CurrentSeatIndex int
 	Rules            Rules
 	History          history.History
+
+	hintTable hintTable
 }
 
 // New returns an initialised game in the SetupPhase with no players.
@@ -39,6 +44,39 @@ func New(bag tile.Bag, board board.Board) *Game {
 	}
 }
 
+// NewAppWithDefaults returns an initialised game in the SetupPhase with no
+// players, with the board layout, 104 tile bag, and rules of the app variant.
+// In the app variant, plays are validated against the dictionary when scored
+// (so invalid words are rejected and challenges aren't allowed), and playing a
+// full rack scores a bonus of scoring.AppMaxRackTilesBonus points.
+func NewAppWithDefaults() *Game {
+	g := New(tile.BagWithAppEnglishTiles(), board.WithAppLayout())
+	g.Rules = g.Rules.
+		WithDictionaryForScoring(true).
+		WithChallengeValidator(challenge.Disallow).
+		WithFullRackBonus(scoring.AppMaxRackTilesBonus)
+	return g
+}
+
+// NewClabbersWithDefaults returns an initialised game in the SetupPhase with no
+// players, with a default bag and board layout, and rules for playing
+// Clabbers with the specified words. In Clabbers, a word formed by a play is
+// valid if it is an anagram of any valid word, both when challenged and (if
+// WithDictionaryForScoring is used) when scoring. Since such words can't be
+// enumerated, Game.Hints returns MoveGeneratorRequiredError unless the rules
+// are given a move generator (see Rules.WithMoveGenerator).
+func NewClabbersWithDefaults(words *dict.WordList) *Game {
+	g := NewWithDefaults()
+	g.Rules = g.Rules.WithDictionary(dict.NewAlphagramIndex(words).Contains)
+	return g
+}
+
+// NewSuperWithDefaults returns an initialised game in the SetupPhase with no
+// players, with the 200 tile bag and 21x21 board layout of the super variant.
+func NewSuperWithDefaults() *Game {
+	return New(tile.BagWithSuperEnglishTiles(), board.WithSuperLayout())
+}
+
 // NewWithDefaults returns an initialised game in the SetupPhase with no
 // players, with a default bag and board layout.
 func NewWithDefaults() *Game {
@@ -119,20 +157,40 @@ func (g *Game) CurrentSeat() *seat.Seat {
 // If the current player doesn't have the required tiles to exchange, an
 // InsufficientTilesError is returned.
 //
-// If an attempt is made to exchange zero tiles, or there are fewer than
-// MaxRackTiles in the bag, tile exchange is illegal and an
-// InvalidTileExchangeError is returned.
+// If an attempt is made to exchange zero tiles, or more tiles than remain in
+// the bag, tile exchange is illegal and an InvalidTileExchangeError is
+// returned. Otherwise, the exchange is checked according to the game's Rules
+// (see Rules.ValidateExchange), which by default require at least a full
+// rack of tiles in the bag. Any points which the exchange costs are deducted
+// from the player's score and recorded in the history.
 func (g *Game) ExchangeTiles(tiles []tile.Tile, r *rand.Rand) error {
 	return g.requirePhase(MainPhase, func() error {
 		if len(tiles) == 0 {
 			return exchange.InvalidTileExchangeError{Reason: exchange.NoTilesExchangedReason}
 		}
-		if len(g.Bag) < tile.MaxRackTiles {
+		if len(g.Bag) < len(tiles) {
 			return exchange.InvalidTileExchangeError{Reason: exchange.InsufficientTilesInBagReason}
 		}
 
 		s := g.CurrentSeat()
 
+		previousExchanges := 0
+		for _, e := range g.History {
+			if e.Type == history.ExchangeTilesEntryType && e.SeatIndex == g.CurrentSeatIndex {
+				previousExchanges++
+			}
+		}
+
+		cost, err := g.Rules.ValidateExchange(exchange.Attempt{
+			Tiles:             tiles,
+			BagTiles:          len(g.Bag),
+			Score:             s.Score,
+			PreviousExchanges: previousExchanges,
+		})
+		if err != nil {
+			return err
+		}
+
 		used, remaining, err := g.Rules.ValidateTilesFromRack(s.Rack, tiles)
 		if err != nil {
 			return err
@@ -146,8 +204,45 @@ func (g *Game) ExchangeTiles(tiles []tile.Tile, r *rand.Rand) error {
 		g.Bag = append(g.Bag, used...)
 		g.Bag.Shuffle(r)
 
-		g.endTurn(0, used, nil, nil)
+		g.endTurn(-cost, used, nil, nil)
+
+		return nil
+	})
+}
 
+// Hints returns up to n of the highest scoring plays which the current player
+// could make with their rack, as found by the game's Rules (see
+// Rules.MoveGenerator). If n is zero or less, all of the plays are returned.
+//
+// The move generator, and the anchors and cross-checks used to find plays, are
+// kept between calls, with the anchors and cross-checks brought up to date
+// from the game's history.
+//
+// If no move generator is available for the rules, MoveGeneratorRequiredError
+// is returned.
+//
+// If the game is not in the Main phase, GameOutOfPhaseError is returned.
+func (g *Game) Hints(n int) (hints []Hint, err error) {
+	return hints, g.requirePhase(MainPhase, func() error {
+		gen, table, err := g.hintTable.forGame(g)
+		if err != nil {
+			return err
+		}
+
+		moves := gen.Moves(&g.Board, g.CurrentSeat().Rack, table)
+		if n > 0 && len(moves) > n {
+			moves = moves[:n]
+		}
+
+		for _, m := range moves {
+			hints = append(hints, Hint{
+				Placements: m.Placements,
+				Notation:   play.Notation(m.Placements, m.Words, &g.Board),
+				Words:      m.Words,
+				Score:      m.Score,
+				Leave:      m.Leave,
+			})
+		}
 		return nil
 	})
 }
@@ -166,40 +261,31 @@ func (g *Game) Pass() error {
 // success, the words formed by the play are returned, the game is updated, and
 // play moves to the next player in turn.
 //
-// Wildcard tiles (tiles with a zero-point tile value) can be played by passing
-// a TilePlacement with the letter replaced by any desired letter (but keeping
-// the point value at zero). The wildcard will be correctly deducted from the
-// player's rack.
+// Blank tiles can be played by passing a TilePlacement with a blank tile which
+// has been designated the desired letter (see tile.Tile.Designate). The blank
+// will be correctly deducted from the player's rack, and remains marked as a
+// blank on the board and in the history.
 //
 // If the game is not in the Main phase, GameOutOfPhaseError is returned.
 //
 // If the current player doesn't have the tiles required to make the play, an
 // InsufficientTilesError is returned.
 //
-// If the tile placement is illegal, an InvalidTilePlacementError is returned.
+// If the tile placement is illegal, an InvalidTilePlacementError is returned
+// (or a PlacementViolationsError, if the Rules report all placement
+// violations).
 //
 // If any formed words are invalid, an InvalidWordError is returned.
 func (g *Game) Play(placements play.Tiles) (playedWords []play.Word, err error) {
 	return playedWords, g.requirePhase(MainPhase, func() error {
-		s := g.CurrentSeat()
-
-		used, remaining, err := g.Rules.ValidateTilesFromRack(s.Rack, placements.Tiles())
-		if err != nil {
-			return err
-		}
-
-		err = g.Rules.ValidatePlacements(placements, &g.Board)
-		if err != nil {
-			return err
-		}
-
 		var score int
-		score, playedWords, err = g.Rules.ScoreWords(placements, &g.Board)
+		var used, remaining []tile.Tile
+		score, playedWords, used, remaining, err = g.checkPlay(placements)
 		if err != nil {
 			return err
 		}
 
-		s.Rack = remaining
+		g.CurrentSeat().Rack = remaining
 		placements.Place(&g.Board)
 		g.endTurn(score, used, placements, playedWords)
 
@@ -207,6 +293,22 @@ func (g *Game) Play(placements play.Tiles) (playedWords []play.Word, err error)
 	})
 }
 
+// PreviewPlay determines the outcome of the current player placing the
+// specified tiles, without making the play. The same checks are made as by
+// Play (including checking words against the dictionary, if the Rules use it
+// for scoring), and the score and words that the play would receive are
+// returned along with the same errors that Play would return. The board,
+// racks, bag and history are left untouched.
+//
+// This is useful for showing the score of a tentative play before committing
+// to it.
+func (g *Game) PreviewPlay(placements play.Tiles) (score int, words []play.Word, err error) {
+	return score, words, g.requirePhase(MainPhase, func() error {
+		score, words, _, _, err = g.checkPlay(placements)
+		return err
+	})
+}
+
 // RemovePlayer removes the seat at the specified index. If no such seat exists,
 // this has no effect.
 //
@@ -241,7 +343,7 @@ func (g *Game) Start(r *rand.Rand) error {
 		g.Bag.Shuffle(r)
 
 		for i := range g.Seats {
-			g.Seats[i].Rack.FillFromBag(&g.Bag)
+			g.Seats[i].Rack.FillFromBagTo(&g.Bag, g.Rules.RackSize())
 		}
 
 		g.Phase = MainPhase
@@ -249,15 +351,44 @@ func (g *Game) Start(r *rand.Rand) error {
 	})
 }
 
+func (g *Game) alphabet() tile.Alphabet {
+	tiles := append([]tile.Tile{}, g.Bag...)
+	for _, s := range g.Seats {
+		tiles = append(tiles, s.Rack...)
+	}
+	for _, p := range g.Board.Positions {
+		if p.Tile != nil {
+			tiles = append(tiles, *p.Tile)
+		}
+	}
+	return tile.AlphabetOf(tiles...)
+}
+
+func (g *Game) checkPlay(placements play.Tiles) (score int, words []play.Word, used, remaining []tile.Tile, err error) {
+	used, remaining, err = g.Rules.ValidateTilesFromRack(g.CurrentSeat().Rack, placements.Tiles())
+	if err != nil {
+		return
+	}
+
+	err = g.Rules.ValidatePlacements(placements, &g.Board)
+	if err != nil {
+		return
+	}
+
+	score, words, err = g.Rules.ScoreWords(placements, &g.Board)
+	return
+}
+
 func (g *Game) endTurn(score int, tilesSpent []tile.Tile, tilesPlayed play.Tiles, wordsFormed []play.Word) {
 	s := g.CurrentSeat()
 	s.Score += score
-	tilesDrawn := s.Rack.FillFromBag(&g.Bag)
+	tilesDrawn := s.Rack.FillFromBagTo(&g.Bag, g.Rules.RackSize())
 
 	if len(tilesPlayed) > 0 {
 		g.History.AppendPlay(g.CurrentSeatIndex, score, tilesSpent, tilesPlayed, tilesDrawn, wordsFormed)
 	} else if len(tilesSpent) > 0 {
 		g.History.AppendExchange(g.CurrentSeatIndex, tilesSpent, tilesDrawn)
+		g.History.Last().Score = score
 	} else {
 		g.History.AppendPass(g.CurrentSeatIndex)
 	}
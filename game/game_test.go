@@ -8,11 +8,14 @@ import (
 	"time"
 
 	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/challenge"
 	"github.com/mandykoh/scrubble/coord"
 	"github.com/mandykoh/scrubble/dict"
 	"github.com/mandykoh/scrubble/exchange"
 	"github.com/mandykoh/scrubble/history"
+	"github.com/mandykoh/scrubble/movegen"
 	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/scoring"
 	"github.com/mandykoh/scrubble/seat"
 	"github.com/mandykoh/scrubble/tile"
 )
@@ -87,24 +90,24 @@ func TestGame(t *testing.T) {
 				Seats: []seat.Seat{
 					{
 						Rack: tile.Rack{
-							{'K', 1},
-							{'P', 1},
-							{'Q', 1},
-							{'Z', 1},
-							{'T', 1},
-							{'R', 1},
-							{'W', 1},
+							tile.Make('K', 1),
+							tile.Make('P', 1),
+							tile.Make('Q', 1),
+							tile.Make('Z', 1),
+							tile.Make('T', 1),
+							tile.Make('R', 1),
+							tile.Make('W', 1),
 						},
 						Score: 123,
 					},
 					{
 						Rack: tile.Rack{
-							{'D', 1},
-							{'A', 1},
-							{'B', 1},
-							{'E', 1},
-							{'O', 1},
-							{'M', 1},
+							tile.Make('D', 1),
+							tile.Make('A', 1),
+							tile.Make('B', 1),
+							tile.Make('E', 1),
+							tile.Make('O', 1),
+							tile.Make('M', 1),
 						},
 						Score: 456,
 					},
@@ -115,9 +118,9 @@ func TestGame(t *testing.T) {
 						Type:        history.PlayEntryType,
 						SeatIndex:   0,
 						Score:       123,
-						TilesSpent:  []tile.Tile{{'A', 1}, {'D', 1}},
+						TilesSpent:  []tile.Tile{tile.Make('A', 1), tile.Make('D', 1)},
 						TilesPlayed: play.Tiles{{tile.Make('A', 1), coord.Make(0, 0)}, {tile.Make('D', 1), coord.Make(0, 1)}},
-						TilesDrawn:  []tile.Tile{{'R', 1}, {'W', 1}},
+						TilesDrawn:  []tile.Tile{tile.Make('R', 1), tile.Make('W', 1)},
 						WordsFormed: nil,
 					},
 				},
@@ -251,13 +254,13 @@ func TestGame(t *testing.T) {
 
 			t.Run("restores the challenged player's rack to how it was before the play", func(t *testing.T) {
 				expectTiles(t, "racked", game.prevSeat().Rack, []tile.Tile{
-					{'K', 1},
-					{'P', 1},
-					{'Q', 1},
-					{'Z', 1},
-					{'T', 1},
-					{'A', 1},
-					{'D', 1},
+					tile.Make('K', 1),
+					tile.Make('P', 1),
+					tile.Make('Q', 1),
+					tile.Make('Z', 1),
+					tile.Make('T', 1),
+					tile.Make('A', 1),
+					tile.Make('D', 1),
 				}...)
 			})
 
@@ -283,17 +286,17 @@ func TestGame(t *testing.T) {
 				Seats: []seat.Seat{
 					{
 						Rack: tile.Rack{
-							{'Z', 10},
+							tile.Make('Z', 10),
 						},
 					},
 					{
 						Rack: tile.Rack{
-							{'D', 1},
-							{'A', 1},
-							{'B', 1},
-							{'E', 1},
-							{'O', 1},
-							{'M', 1},
+							tile.Make('D', 1),
+							tile.Make('A', 1),
+							tile.Make('B', 1),
+							tile.Make('E', 1),
+							tile.Make('O', 1),
+							tile.Make('M', 1),
 						},
 					},
 				},
@@ -353,11 +356,11 @@ func TestGame(t *testing.T) {
 			}
 
 			tiles := []tile.Tile{
-				{'B', 1},
-				{'O', 1},
-				{'O', 1},
-				{'M', 1},
-				{'S', 1},
+				tile.Make('B', 1),
+				tile.Make('O', 1),
+				tile.Make('O', 1),
+				tile.Make('M', 1),
+				tile.Make('S', 1),
 			}
 
 			err := game.ExchangeTiles(tiles, nil)
@@ -395,9 +398,9 @@ func TestGame(t *testing.T) {
 			}
 
 			tilesExchanged := []tile.Tile{
-				{'B', 1},
-				{'M', 1},
-				{'D', 1},
+				tile.Make('B', 1),
+				tile.Make('M', 1),
+				tile.Make('D', 1),
 			}
 
 			expectedBag = append(expectedBag, tilesExchanged...)
@@ -425,9 +428,9 @@ func TestGame(t *testing.T) {
 					t.Errorf("Expected player's rack to have been replenished to %d tiles but found %d", expected, actual)
 				} else {
 					expectTiles(t, "racked", rack, []tile.Tile{
-						{'A', 1},
-						{'E', 1},
-						{'O', 1},
+						tile.Make('A', 1),
+						tile.Make('E', 1),
+						tile.Make('O', 1),
 						nextBagTiles[0],
 						nextBagTiles[1],
 						nextBagTiles[2],
@@ -455,6 +458,108 @@ func TestGame(t *testing.T) {
 			})
 		})
 
+		t.Run("returns an error when more tiles are exchanged than are in the bag", func(t *testing.T) {
+			game := setupGame()
+			game.Bag = game.Bag[:1]
+			game.Rules = game.Rules.WithExchangeValidator(exchange.MinBagTiles(1))
+
+			err := game.ExchangeTiles([]tile.Tile{
+				game.CurrentSeat().Rack[0],
+				game.CurrentSeat().Rack[1],
+			}, nil)
+
+			if actual, expected := err, (exchange.InvalidTileExchangeError{Reason: exchange.InsufficientTilesInBagReason}); actual != expected {
+				t.Fatalf("Expected error %v but was %v", expected, err)
+			}
+		})
+
+		t.Run("with an exchange validator", func(t *testing.T) {
+			var attempts []exchange.Attempt
+
+			setupGameWithValidator := func(validate exchange.Validator) Game {
+				attempts = nil
+				game := setupGame()
+				game.Bag = game.Bag[:2]
+				game.Seats[1].Score = 15
+				game.History.AppendExchange(1, nil, nil)
+				game.History.AppendExchange(0, nil, nil)
+				game.History.AppendPass(1)
+				game.Rules = game.Rules.WithExchangeValidator(func(attempt exchange.Attempt) (int, error) {
+					attempts = append(attempts, attempt)
+					return validate(attempt)
+				})
+				return game
+			}
+
+			t.Run("uses the validator instead of the default bag size rule", func(t *testing.T) {
+				game := setupGameWithValidator(exchange.MinBagTiles(1))
+
+				err := game.ExchangeTiles([]tile.Tile{game.CurrentSeat().Rack[0]}, rand.New(rand.NewSource(0)))
+
+				if err != nil {
+					t.Fatalf("Expected success but got error %v", err)
+				}
+				if actual, expected := len(attempts), 1; actual != expected {
+					t.Fatalf("Expected validator to be called once but got %d invocations", actual)
+				}
+
+				attempt := attempts[0]
+				expectTiles(t, "exchanged", attempt.Tiles, tile.Make('D', 1))
+				if actual, expected := attempt.BagTiles, 2; actual != expected {
+					t.Errorf("Expected attempt to report %d tiles in the bag but got %d", expected, actual)
+				}
+				if actual, expected := attempt.Score, 15; actual != expected {
+					t.Errorf("Expected attempt to report a score of %d but got %d", expected, actual)
+				}
+				if actual, expected := attempt.PreviousExchanges, 1; actual != expected {
+					t.Errorf("Expected attempt to report %d previous exchanges but got %d", expected, actual)
+				}
+			})
+
+			t.Run("returns errors from the validator", func(t *testing.T) {
+				game := setupGameWithValidator(exchange.MaxExchanges(1))
+
+				err := game.ExchangeTiles([]tile.Tile{game.CurrentSeat().Rack[0]}, rand.New(rand.NewSource(0)))
+
+				if actual, expected := err, (exchange.InvalidTileExchangeError{Reason: exchange.ExchangeLimitReachedReason}); actual != expected {
+					t.Errorf("Expected error %v but was %v", expected, actual)
+				}
+				if actual, expected := len(game.History), 3; actual != expected {
+					t.Errorf("Expected no history entry to be recorded but found %d entries", actual)
+				}
+			})
+
+			t.Run("deducts the cost of the exchange from the player's score", func(t *testing.T) {
+				game := setupGameWithValidator(exchange.All(exchange.MinBagTiles(1), exchange.Cost(10)))
+
+				err := game.ExchangeTiles([]tile.Tile{game.CurrentSeat().Rack[0]}, rand.New(rand.NewSource(0)))
+
+				if err != nil {
+					t.Fatalf("Expected success but got error %v", err)
+				}
+				if actual, expected := game.Seats[1].Score, 5; actual != expected {
+					t.Errorf("Expected player's score to be %d but was %d", expected, actual)
+				}
+				if actual, expected := game.History.Last().Score, -10; actual != expected {
+					t.Errorf("Expected history entry to record a score of %d but was %d", expected, actual)
+				}
+			})
+
+			t.Run("allows the cost of the exchange to leave the player with a negative score", func(t *testing.T) {
+				game := setupGameWithValidator(exchange.All(exchange.MinBagTiles(1), exchange.Cost(10)))
+				game.CurrentSeat().Score = 0
+
+				err := game.ExchangeTiles([]tile.Tile{game.CurrentSeat().Rack[0]}, rand.New(rand.NewSource(0)))
+
+				if err != nil {
+					t.Fatalf("Expected success but got error %v", err)
+				}
+				if actual, expected := game.Seats[1].Score, -10; actual != expected {
+					t.Errorf("Expected player's score to be %d but was %d", expected, actual)
+				}
+			})
+		})
+
 		t.Run("with a game-ending play (eg final consecutive scoreless turn)", func(t *testing.T) {
 			game := setupGame()
 			game.Rules = game.Rules.WithGamePhaseController(func(*Game) Phase {
@@ -493,6 +598,158 @@ func TestGame(t *testing.T) {
 		})
 	})
 
+	t.Run(".Hints()", func(t *testing.T) {
+
+		words := dict.NewWordList([]string{"CAT", "ACT", "AT"}, dict.FoldCase("en"))
+		alphabet := tile.AlphabetOf(tile.Make('A', 1), tile.Make('C', 3), tile.Make('T', 1))
+
+		setupGame := func() Game {
+			game := Game{
+				Phase: MainPhase,
+				Board: board.WithStandardLayout(),
+				Rules: Rules{}.WithMoveGenerator(movegen.New(words, alphabet)),
+				Seats: []seat.Seat{
+					{
+						Rack: tile.Rack{
+							tile.Make('C', 3),
+							tile.Make('A', 1),
+							tile.Make('T', 1),
+						},
+					},
+					{
+						Rack: tile.Rack{
+							tile.Make('Q', 10),
+						},
+					},
+				},
+			}
+
+			return game
+		}
+
+		t.Run("returns an error when the game is not in the Main phase", func(t *testing.T) {
+			game := Game{
+				Phase: SetupPhase,
+			}
+
+			_, err := game.Hints(1)
+
+			if actual, expected := err, (OutOfPhaseError{MainPhase, SetupPhase}); actual != expected {
+				t.Fatalf("Expected error %v but was %v", expected, err)
+			}
+		})
+
+		t.Run("returns an error when the dictionary has been overridden without a move generator", func(t *testing.T) {
+			game := setupGame()
+			game.Rules = Rules{}.WithDictionary(words.Contains)
+
+			_, err := game.Hints(1)
+
+			if actual, expected := err, (MoveGeneratorRequiredError{}); actual != expected {
+				t.Errorf("Expected error %v but was %v", expected, err)
+			}
+		})
+
+		t.Run("returns the highest scoring plays for the current seat", func(t *testing.T) {
+			game := setupGame()
+
+			hints, err := game.Hints(2)
+
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if actual, expected := len(hints), 2; actual != expected {
+				t.Fatalf("Expected %d hints but got %d", expected, actual)
+			}
+			for _, h := range hints {
+				if actual, expected := h.Score, 10; actual != expected {
+					t.Errorf("Expected hint %q to score %d but got %d", h.Notation, expected, actual)
+				}
+				if actual, expected := len(h.Words), 1; actual != expected {
+					t.Errorf("Expected hint %q to form %d word but got %d", h.Notation, expected, actual)
+				}
+				if actual, expected := len(h.Leave), 0; actual != expected {
+					t.Errorf("Expected hint %q to leave %d tiles but got %d", h.Notation, expected, actual)
+				}
+				if expected := h.Notation[len(h.Notation)-3:]; h.Words[0].Word != expected {
+					t.Errorf("Expected notation %q to end with the word %q", h.Notation, h.Words[0].Word)
+				}
+			}
+		})
+
+		t.Run("returns all plays when n is zero", func(t *testing.T) {
+			game := setupGame()
+
+			hints, _ := game.Hints(0)
+
+			if actual, expected := len(hints), 16; actual != expected {
+				t.Errorf("Expected %d hints but got %d", expected, actual)
+			}
+		})
+
+		t.Run("keeps hints up to date as plays are made", func(t *testing.T) {
+			game := setupGame()
+			game.Seats[1].Rack = tile.Rack{tile.Make('A', 1), tile.Make('C', 3)}
+			game.Hints(0)
+
+			_, err := game.Play(play.Tiles{
+				{Tile: tile.Make('A', 1), Coord: coord.Make(7, 7)},
+				{Tile: tile.Make('T', 1), Coord: coord.Make(7, 8)},
+			})
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+
+			hints, _ := game.Hints(0)
+			fresh := game
+			freshHints, _ := fresh.Hints(0)
+
+			if actual, expected := len(hints), len(freshHints); actual != expected || actual == 0 {
+				t.Fatalf("Expected %d hints but got %d", expected, actual)
+			}
+			for i, h := range hints {
+				if actual, expected := h.Notation, freshHints[i].Notation; actual != expected {
+					t.Errorf("Expected hint %d to be %q but got %q", i, expected, actual)
+				}
+			}
+		})
+
+		t.Run("uses the move generator of the current rules", func(t *testing.T) {
+			game := setupGame()
+			game.Hints(0)
+
+			game.Rules = game.Rules.WithMoveGenerator(movegen.New(dict.NewWordList([]string{"AT"}, dict.FoldCase("en")), alphabet))
+
+			hints, _ := game.Hints(0)
+
+			if len(hints) == 0 {
+				t.Fatalf("Expected hints but got none")
+			}
+			for _, h := range hints {
+				if actual, expected := h.Words[0].Word, "AT"; actual != expected {
+					t.Errorf("Expected hint %q to form %q but got %q", h.Notation, expected, actual)
+				}
+			}
+		})
+
+		t.Run("scores plays according to the rules", func(t *testing.T) {
+			game := setupGame()
+			game.Rules = game.Rules.WithWordScorer(func(placements play.Tiles, b *board.Board, isWordValid dict.Dictionary) (int, []play.Word, error) {
+				score, words, err := scoring.ScoreWords(placements, b, isWordValid)
+				if len(placements) == 2 {
+					score += 100
+				}
+				return score, words, err
+			})
+
+			hints, _ := game.Hints(1)
+
+			if actual, expected := hints[0].Score, 104; actual != expected {
+				t.Errorf("Expected best hint to score %d but got %d", expected, actual)
+			}
+		})
+	})
+
 	t.Run(".Pass()", func(t *testing.T) {
 
 		setupGame := func() Game {
@@ -502,16 +759,16 @@ func TestGame(t *testing.T) {
 				Seats: []seat.Seat{
 					{
 						Rack: tile.Rack{
-							{'A', 1},
-							{'B', 1},
-							{'C', 1},
+							tile.Make('A', 1),
+							tile.Make('B', 1),
+							tile.Make('C', 1),
 						},
 					},
 					{
 						Rack: tile.Rack{
-							{'E', 2},
-							{'F', 2},
-							{'G', 2},
+							tile.Make('E', 2),
+							tile.Make('F', 2),
+							tile.Make('G', 2),
 						},
 					},
 				},
@@ -536,13 +793,13 @@ func TestGame(t *testing.T) {
 		t.Run("records a history entry", func(t *testing.T) {
 			game := setupGame()
 			game.CurrentSeat().Rack = tile.Rack{
-				{'A', 1},
-				{'B', 1},
-				{'C', 1},
-				{'D', 1},
-				{'E', 1},
-				{'F', 1},
-				{'G', 1},
+				tile.Make('A', 1),
+				tile.Make('B', 1),
+				tile.Make('C', 1),
+				tile.Make('D', 1),
+				tile.Make('E', 1),
+				tile.Make('F', 1),
+				tile.Make('G', 1),
 			}
 
 			err := game.Pass()
@@ -616,12 +873,12 @@ func TestGame(t *testing.T) {
 			wordsScored = 0
 
 			rackTiles := []tile.Tile{
-				{'A', 1},
-				{'B', 1},
-				{'E', 1},
-				{'O', 1},
-				{'D', 1},
-				{'M', 1},
+				tile.Make('A', 1),
+				tile.Make('B', 1),
+				tile.Make('E', 1),
+				tile.Make('O', 1),
+				tile.Make('D', 1),
+				tile.Make('M', 1),
 			}
 
 			game := Game{
@@ -675,11 +932,11 @@ func TestGame(t *testing.T) {
 			}
 
 			playTiles := []tile.Tile{
-				{'B', 1},
-				{'O', 1},
-				{'O', 1},
-				{'M', 1},
-				{'S', 1},
+				tile.Make('B', 1),
+				tile.Make('O', 1),
+				tile.Make('O', 1),
+				tile.Make('M', 1),
+				tile.Make('S', 1),
 			}
 
 			var placements play.Tiles
@@ -701,12 +958,12 @@ func TestGame(t *testing.T) {
 
 			t.Run("does not remove tiles from the player's rack", func(t *testing.T) {
 				expectTiles(t, "racked", game.CurrentSeat().Rack, []tile.Tile{
-					{'A', 1},
-					{'B', 1},
-					{'E', 1},
-					{'O', 1},
-					{'D', 1},
-					{'M', 1},
+					tile.Make('A', 1),
+					tile.Make('B', 1),
+					tile.Make('E', 1),
+					tile.Make('O', 1),
+					tile.Make('D', 1),
+					tile.Make('M', 1),
 				}...)
 			})
 		})
@@ -745,7 +1002,7 @@ func TestGame(t *testing.T) {
 
 		t.Run("with a valid play", func(t *testing.T) {
 			game := setupGame()
-			game.Board.Position(coord.Make(0, 1)).Tile = &tile.Tile{Letter: 'A', Points: 1}
+			game.Board.Position(coord.Make(0, 1)).Tile = &tile.Tile{Letter: "A", Points: 1}
 
 			nextBagTiles := []tile.Tile{
 				game.Bag[len(game.Bag)-1],
@@ -798,10 +1055,10 @@ func TestGame(t *testing.T) {
 				rack := game.prevSeat().Rack
 
 				expectTiles(t, "racked", rack, []tile.Tile{
-					{'A', 1},
-					{'E', 1},
-					{'O', 1},
-					{'M', 1},
+					tile.Make('A', 1),
+					tile.Make('E', 1),
+					tile.Make('O', 1),
+					tile.Make('M', 1),
 					nextBagTiles[0],
 					nextBagTiles[1],
 					nextBagTiles[2],
@@ -827,6 +1084,38 @@ func TestGame(t *testing.T) {
 			})
 		})
 
+		t.Run("with a blank tile", func(t *testing.T) {
+			game := setupGame()
+			game.Seats[1].Rack[0] = tile.MakeBlank()
+
+			placements := play.Tiles{
+				{tile.MakeBlank().Designate("Z"), coord.Make(7, 7)},
+				{tile.Make('O', 1), coord.Make(7, 8)},
+			}
+			_, err := game.Play(placements)
+
+			if err != nil {
+				t.Fatalf("Expected play to succeed but got error %v", err)
+			}
+
+			t.Run("places the designated blank on the board", func(t *testing.T) {
+				if actual, expected := game.Board.Position(coord.Make(7, 7)).Tile, (tile.Tile{Letter: "Z", Points: 0, Blank: true}); actual == nil || *actual != expected {
+					t.Errorf("Expected tile %v but got %v", expected, actual)
+				}
+			})
+
+			t.Run("records the blank in the history", func(t *testing.T) {
+				entry := game.History.Last()
+
+				if actual, expected := entry.TilesSpent[0], tile.MakeBlank(); actual != expected {
+					t.Errorf("Expected spent tile %v but got %v", expected, actual)
+				}
+				if actual, expected := entry.TilesPlayed[0].Tile, placements[0].Tile; actual != expected {
+					t.Errorf("Expected played tile %v but got %v", expected, actual)
+				}
+			})
+		})
+
 		t.Run("with a game-ending play", func(t *testing.T) {
 			game := setupGame()
 			game.Rules = game.Rules.WithGamePhaseController(func(*Game) Phase {
@@ -838,7 +1127,7 @@ func TestGame(t *testing.T) {
 				return
 			})
 
-			game.Board.Position(coord.Make(0, 1)).Tile = &tile.Tile{Letter: 'A', Points: 1}
+			game.Board.Position(coord.Make(0, 1)).Tile = &tile.Tile{Letter: "A", Points: 1}
 
 			placements := play.Tiles{
 				{tile.Make('B', 1), coord.Make(0, 0)},
@@ -863,6 +1152,147 @@ func TestGame(t *testing.T) {
 		})
 	})
 
+	t.Run(".PreviewPlay()", func(t *testing.T) {
+
+		setupGame := func() Game {
+			game := Game{
+				Phase: MainPhase,
+				Bag:   tile.Bag{tile.Make('Z', 10), tile.Make('Q', 10)},
+				Board: board.WithStandardLayout(),
+				Seats: []seat.Seat{
+					{
+						Rack: tile.Rack{
+							tile.Make('C', 3),
+							tile.Make('A', 1),
+							tile.Make('T', 1),
+						},
+					},
+					{
+						Rack: tile.Rack{
+							tile.Make('Q', 10),
+						},
+					},
+				},
+				Rules: Rules{}.
+					WithDictionary(func(word string) bool { return word == "CAT" }).
+					WithDictionaryForScoring(true),
+			}
+
+			return game
+		}
+
+		expectUntouched := func(t *testing.T, game *Game) {
+			t.Helper()
+
+			if !game.Board.IsEmpty() {
+				t.Errorf("Expected board to remain empty")
+			}
+			expectTiles(t, "racked", game.CurrentSeat().Rack, tile.Make('C', 3), tile.Make('A', 1), tile.Make('T', 1))
+			if actual, expected := len(game.Bag), 2; actual != expected {
+				t.Errorf("Expected %d tiles to remain in the bag but found %d", expected, actual)
+			}
+			if actual, expected := len(game.History), 0; actual != expected {
+				t.Errorf("Expected no history entries but found %d", actual)
+			}
+			if actual, expected := game.CurrentSeatIndex, 0; actual != expected {
+				t.Errorf("Expected current seat to remain %d but was %d", expected, actual)
+			}
+		}
+
+		t.Run("returns an error when the game is not in the Main phase", func(t *testing.T) {
+			game := Game{
+				Phase: SetupPhase,
+			}
+
+			_, _, err := game.PreviewPlay(play.Tiles{
+				{tile.Make('A', 1), coord.Make(7, 7)},
+			})
+
+			if actual, expected := err, (OutOfPhaseError{MainPhase, SetupPhase}); actual != expected {
+				t.Fatalf("Expected error %v but was %v", expected, err)
+			}
+		})
+
+		t.Run("returns the score and words for a valid play without making it", func(t *testing.T) {
+			game := setupGame()
+			placements := play.Tiles{
+				{tile.Make('C', 3), coord.Make(7, 7)},
+				{tile.Make('A', 1), coord.Make(7, 8)},
+				{tile.Make('T', 1), coord.Make(7, 9)},
+			}
+
+			score, words, err := game.PreviewPlay(placements)
+
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if actual, expected := score, 10; actual != expected {
+				t.Errorf("Expected score of %d but got %d", expected, actual)
+			}
+			if actual, expected := len(words), 1; actual != expected {
+				t.Fatalf("Expected %d word but got %d", expected, actual)
+			}
+			if actual, expected := words[0].Word, "CAT"; actual != expected {
+				t.Errorf("Expected word %s but got %s", expected, actual)
+			}
+			expectUntouched(t, &game)
+
+			playedWords, _ := game.Play(placements)
+
+			if actual, expected := game.History.Last().Score, score; actual != expected {
+				t.Errorf("Expected play to score %d as previewed but got %d", expected, actual)
+			}
+			if actual, expected := playedWords[0].Word, words[0].Word; actual != expected {
+				t.Errorf("Expected play to form %s as previewed but got %s", expected, actual)
+			}
+		})
+
+		t.Run("returns an error when the rack doesn't have the tiles", func(t *testing.T) {
+			game := setupGame()
+
+			_, _, err := game.PreviewPlay(play.Tiles{
+				{tile.Make('C', 3), coord.Make(7, 7)},
+				{tile.Make('U', 1), coord.Make(7, 8)},
+				{tile.Make('T', 1), coord.Make(7, 9)},
+			})
+
+			if _, ok := err.(tile.InsufficientTilesError); !ok {
+				t.Errorf("Expected an InsufficientTilesError but got %v", err)
+			}
+			expectUntouched(t, &game)
+		})
+
+		t.Run("returns an error when the placement is invalid", func(t *testing.T) {
+			game := setupGame()
+
+			_, _, err := game.PreviewPlay(play.Tiles{
+				{tile.Make('C', 3), coord.Make(0, 0)},
+				{tile.Make('A', 1), coord.Make(0, 1)},
+				{tile.Make('T', 1), coord.Make(0, 2)},
+			})
+
+			if _, ok := err.(play.InvalidTilePlacementError); !ok {
+				t.Errorf("Expected an InvalidTilePlacementError but got %v", err)
+			}
+			expectUntouched(t, &game)
+		})
+
+		t.Run("returns an error when words are invalid", func(t *testing.T) {
+			game := setupGame()
+
+			_, _, err := game.PreviewPlay(play.Tiles{
+				{tile.Make('A', 1), coord.Make(7, 7)},
+				{tile.Make('C', 3), coord.Make(7, 8)},
+				{tile.Make('T', 1), coord.Make(7, 9)},
+			})
+
+			if _, ok := err.(play.InvalidWordError); !ok {
+				t.Errorf("Expected an InvalidWordError but got %v", err)
+			}
+			expectUntouched(t, &game)
+		})
+	})
+
 	t.Run(".RemovePlayer()", func(t *testing.T) {
 
 		t.Run("removes the seat for the specified player", func(t *testing.T) {
@@ -1045,5 +1475,178 @@ func TestGame(t *testing.T) {
 				t.Errorf("Expected no error but got %v", actual)
 			}
 		})
+
+		t.Run("fills racks to the rack size given by the rules", func(t *testing.T) {
+			game := Game{
+				Bag:   tile.BagWithStandardEnglishTiles(),
+				Board: board.WithStandardLayout(),
+				Rules: Rules{}.WithRackSize(8),
+			}
+			game.AddPlayer()
+			game.AddPlayer()
+
+			game.Start(rand.New(rand.NewSource(seed)))
+
+			for i, s := range game.Seats {
+				if actual, expected := len(s.Rack), 8; actual != expected {
+					t.Errorf("Expected rack of player %d to hold %d tiles but found %d", i, expected, actual)
+				}
+			}
+
+			game.Pass()
+			s := game.CurrentSeat()
+			s.Rack = s.Rack[:5]
+			game.Pass()
+
+			if actual, expected := len(s.Rack), 8; actual != expected {
+				t.Errorf("Expected rack to be refilled to %d tiles at the end of the turn but found %d", expected, actual)
+			}
+		})
+	})
+
+	t.Run("NewAppWithDefaults()", func(t *testing.T) {
+
+		setupGame := func() *Game {
+			game := NewAppWithDefaults()
+			game.Rules = game.Rules.WithDictionary(func(word string) bool { return word != "XX" })
+			game.AddPlayer()
+			game.AddPlayer()
+			game.Start(rand.New(rand.NewSource(1)))
+
+			game.CurrentSeat().Rack = tile.Rack{
+				tile.Make('R', 1), tile.Make('E', 1), tile.Make('T', 1), tile.Make('A', 1),
+				tile.Make('I', 1), tile.Make('N', 2), tile.Make('S', 1),
+			}
+			return game
+		}
+
+		t.Run("awards the app bonus for playing a full rack", func(t *testing.T) {
+			game := setupGame()
+
+			_, err := game.Play(play.Tiles{
+				{tile.Make('R', 1), coord.Make(7, 1)},
+				{tile.Make('E', 1), coord.Make(7, 2)},
+				{tile.Make('T', 1), coord.Make(7, 3)},
+				{tile.Make('A', 1), coord.Make(7, 4)},
+				{tile.Make('I', 1), coord.Make(7, 5)},
+				{tile.Make('N', 2), coord.Make(7, 6)},
+				{tile.Make('S', 1), coord.Make(7, 7)},
+			})
+
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if actual, expected := game.History.Last().Score, 2*(2*8)+scoring.AppMaxRackTilesBonus; actual != expected {
+				t.Errorf("Expected score of %d but got %d", expected, actual)
+			}
+		})
+
+		t.Run("awards the app bonus only for a full rack when the rack size is changed", func(t *testing.T) {
+			game := setupGame()
+			game.Rules = game.Rules.WithRackSize(8)
+
+			_, err := game.Play(play.Tiles{
+				{tile.Make('R', 1), coord.Make(7, 1)},
+				{tile.Make('E', 1), coord.Make(7, 2)},
+				{tile.Make('T', 1), coord.Make(7, 3)},
+				{tile.Make('A', 1), coord.Make(7, 4)},
+				{tile.Make('I', 1), coord.Make(7, 5)},
+				{tile.Make('N', 2), coord.Make(7, 6)},
+				{tile.Make('S', 1), coord.Make(7, 7)},
+			})
+
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if actual, expected := game.History.Last().Score, 2*(2*8); actual != expected {
+				t.Errorf("Expected score of %d but got %d", expected, actual)
+			}
+		})
+
+		t.Run("rejects invalid words when they are played", func(t *testing.T) {
+			game := setupGame()
+			game.CurrentSeat().Rack = tile.Rack{tile.Make('X', 8), tile.Make('X', 8)}
+
+			_, err := game.Play(play.Tiles{
+				{tile.Make('X', 8), coord.Make(7, 7)},
+				{tile.Make('X', 8), coord.Make(7, 8)},
+			})
+
+			if _, ok := err.(play.InvalidWordError); !ok {
+				t.Errorf("Expected an InvalidWordError but got %v", err)
+			}
+		})
+
+		t.Run("doesn't allow challenges", func(t *testing.T) {
+			game := setupGame()
+			game.Pass()
+
+			_, err := game.Challenge(game.CurrentSeatIndex, rand.New(rand.NewSource(1)))
+
+			if actual, expected := err, (challenge.InvalidChallengeError{Reason: challenge.ChallengesNotAllowedReason}); actual != expected {
+				t.Errorf("Expected error %v but got %v", expected, actual)
+			}
+		})
+	})
+	t.Run("NewClabbersWithDefaults()", func(t *testing.T) {
+		words := dict.NewWordList([]string{"cab", "dab"}, dict.FoldCase("en"))
+
+		setupGame := func() *Game {
+			game := NewClabbersWithDefaults(words)
+			game.AddPlayer()
+			game.AddPlayer()
+			game.Start(rand.New(rand.NewSource(1)))
+
+			game.CurrentSeat().Rack = tile.Rack{tile.Make('A', 1), tile.Make('B', 3), tile.Make('C', 3), tile.Make('D', 2)}
+			return game
+		}
+
+		t.Run("scores plays which are anagrams of valid words", func(t *testing.T) {
+			game := setupGame()
+			game.Rules = game.Rules.WithDictionaryForScoring(true)
+
+			_, err := game.Play(play.Tiles{
+				{tile.Make('B', 3), coord.Make(7, 7)},
+				{tile.Make('C', 3), coord.Make(7, 8)},
+				{tile.Make('A', 1), coord.Make(7, 9)},
+			})
+
+			if err != nil {
+				t.Errorf("Expected no error but got %v", err)
+			}
+		})
+
+		t.Run("rejects plays which aren't anagrams of valid words", func(t *testing.T) {
+			game := setupGame()
+			game.Rules = game.Rules.WithDictionaryForScoring(true)
+
+			_, err := game.Play(play.Tiles{
+				{tile.Make('B', 3), coord.Make(7, 7)},
+				{tile.Make('C', 3), coord.Make(7, 8)},
+				{tile.Make('D', 2), coord.Make(7, 9)},
+			})
+
+			if _, ok := err.(play.InvalidWordError); !ok {
+				t.Errorf("Expected an InvalidWordError but got %v", err)
+			}
+		})
+
+		t.Run("adjudicates challenges by anagram", func(t *testing.T) {
+			game := setupGame()
+
+			game.Play(play.Tiles{
+				{tile.Make('B', 3), coord.Make(7, 7)},
+				{tile.Make('C', 3), coord.Make(7, 8)},
+				{tile.Make('A', 1), coord.Make(7, 9)},
+			})
+			success, err := game.Challenge(game.CurrentSeatIndex, rand.New(rand.NewSource(1)))
+
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if success {
+				t.Errorf("Expected challenge of an anagram of a valid word to fail")
+			}
+		})
 	})
 }
@@ -0,0 +1,94 @@
+package game
+
+import (
+	"strings"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/crosscheck"
+	"github.com/mandykoh/scrubble/history"
+	"github.com/mandykoh/scrubble/movegen"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// Hint represents a play which could be made by the current player, as
+// suggested by Game.Hints.
+type Hint struct {
+
+	// Placements are the tiles to be placed for the play.
+	Placements play.Tiles
+
+	// Notation is the play written in conventional notation (see
+	// play.Notation).
+	Notation string
+
+	// Words are the words the play would form.
+	Words []play.Word
+
+	// Score is the score the play would receive.
+	Score int
+
+	// Leave is the tiles which would remain on the rack after the play.
+	Leave []tile.Tile
+}
+
+// hintTable keeps the move generator and a table of anchors and cross-checks
+// for finding hints, so that the generator only needs to be built once, and
+// the table only needs to be updated for the plays made since it was last
+// used.
+type hintTable struct {
+	key       hintGeneratorKey
+	generator *movegen.Generator
+	board     *board.Board
+	table     *crosscheck.Table
+	entries   int
+}
+
+// hintGeneratorKey identifies everything a move generator given by the rules
+// depends on, so that the generator can be rebuilt if any of it changes.
+type hintGeneratorKey struct {
+	rules         *Rules
+	moveGenerator *movegen.Generator
+	hasDictionary bool
+	alphabet      string
+}
+
+func (t *hintTable) forGame(g *Game) (*movegen.Generator, *crosscheck.Table, error) {
+	alphabet := g.alphabet()
+	key := hintGeneratorKey{
+		rules:         &g.Rules,
+		moveGenerator: g.Rules.moveGenerator,
+		hasDictionary: g.Rules.dictionary != nil,
+		alphabet:      strings.Join(alphabet, "|"),
+	}
+
+	if t.generator == nil || t.key != key {
+		gen, err := g.Rules.MoveGenerator(alphabet)
+		if err != nil {
+			return nil, nil, err
+		}
+		t.key = key
+		t.generator = gen
+		t.table = nil
+	}
+
+	if t.table == nil || t.board != &g.Board || t.entries > len(g.History) {
+		t.board = &g.Board
+		t.table = t.generator.Table(&g.Board)
+
+	} else {
+		for i := t.entries; i < len(g.History); i++ {
+			switch g.History[i].Type {
+			case history.PlayEntryType:
+				t.table.Update(g.History[i].TilesPlayed)
+			case history.ChallengeSuccessEntryType:
+				if i > 0 {
+					t.table.Update(g.History[i-1].TilesPlayed)
+				}
+			}
+		}
+	}
+
+	t.entries = len(g.History)
+	return t.generator, t.table, nil
+}
@@ -0,0 +1,13 @@
+package game
+
+import "fmt"
+
+// MoveGeneratorRequiredError indicates that the plays available to a player
+// couldn't be found, because the rules use a dictionary other than the default
+// without a move generator for it (see Rules.WithMoveGenerator).
+type MoveGeneratorRequiredError struct {
+}
+
+func (e MoveGeneratorRequiredError) Error() string {
+	return fmt.Sprintf("%#v", e)
+}
@@ -1,6 +1,10 @@
 package game
 
-import "github.com/mandykoh/scrubble/history"
+import (
+	"time"
+
+	"github.com/mandykoh/scrubble/history"
+)
 
 // MaxScorelessTurns represents the maximum number of consecutive scoreless
 // turns for the game to end.
@@ -10,31 +14,157 @@ const MaxScorelessTurns = 6
 // after a turn is played. This is called by Game at the end of each turn.
 type PhaseController func(game *Game) (next Phase)
 
-// NextPhase implements a PhaseController with the default game progression
-// and ending conditions.
-func NextPhase(game *Game) Phase {
-	lastTurn := game.History.Last()
+var defaultPhaseController = EndOnAny(
+	EndOnRackEmptied,
+	EndAfterScorelessTurns(MaxScorelessTurns),
+)
 
-	// Last player's rack was empty, which means they played out
-	if len(game.Seats[lastTurn.SeatIndex].Rack) == 0 {
+// EndAfterEachPlayerPasses returns a PhaseController which ends the game once
+// every player has passed on each of their last n turns. Successfully
+// challenged plays are not counted as passes.
+func EndAfterEachPlayerPasses(n int) PhaseController {
+	return func(game *Game) Phase {
+		passes := make([]int, len(game.Seats))
+		counted := make([]bool, len(game.Seats))
+
+		for i := len(game.History) - 1; i >= 0; i-- {
+			entry := &game.History[i]
+			seatIndex := entry.SeatIndex
+
+			switch entry.Type {
+			case history.ChallengeFailEntryType:
+				continue
+			case history.ChallengeSuccessEntryType:
+				i--
+				if i < 0 {
+					continue
+				}
+				seatIndex = game.History[i].SeatIndex
+			}
+
+			if seatIndex < 0 || seatIndex >= len(game.Seats) || counted[seatIndex] {
+				continue
+			}
+
+			if entry.Type == history.PassEntryType {
+				passes[seatIndex]++
+			} else {
+				counted[seatIndex] = true
+			}
+		}
+
+		for _, p := range passes {
+			if p < n {
+				return MainPhase
+			}
+		}
 		return EndPhase
 	}
+}
+
+// EndAfterScorelessTurns returns a PhaseController which ends the game after
+// n consecutive scoreless turns. Successfully challenged plays are counted as
+// scoreless turns.
+func EndAfterScorelessTurns(n int) PhaseController {
+	return func(game *Game) Phase {
+		scoreless := 0
+		for i := len(game.History) - 1; i >= 0; i-- {
+			entry := &game.History[i]
 
-	scoreless := 0
-	for i := len(game.History) - 1; i >= 0; i-- {
-		entry := &game.History[i]
+			if entry.Type == history.ChallengeSuccessEntryType {
+				i--
+			} else if entry.Score > 0 {
+				break
+			}
 
-		if entry.Type == history.ChallengeSuccessEntryType {
-			i--
-		} else if entry.Score > 0 {
-			break
+			scoreless++
+			if scoreless >= n {
+				return EndPhase
+			}
 		}
 
-		scoreless++
-		if scoreless >= MaxScorelessTurns {
+		return MainPhase
+	}
+}
+
+// EndAfterTurns returns a PhaseController which ends the game once n turns
+// have been taken. Plays, exchanges, and passes are counted as turns, but
+// challenges are not.
+func EndAfterTurns(n int) PhaseController {
+	return func(game *Game) Phase {
+		turns := 0
+		for _, entry := range game.History {
+			switch entry.Type {
+			case history.PlayEntryType, history.ExchangeTilesEntryType, history.PassEntryType:
+				turns++
+			}
+		}
+
+		if turns >= n {
 			return EndPhase
 		}
+		return MainPhase
 	}
+}
 
+// EndAtDeadline returns a PhaseController which ends the game at the end of
+// the first turn finished at or after the specified deadline, according to the
+// given clock (eg time.Now). A wall-clock limit on a game can be imposed with
+// a deadline of time.Now().Add(limit).
+func EndAtDeadline(deadline time.Time, now func() time.Time) PhaseController {
+	return func(game *Game) Phase {
+		if now().Before(deadline) {
+			return MainPhase
+		}
+		return EndPhase
+	}
+}
+
+// EndAtScore returns a PhaseController which ends the game as soon as any
+// player's score reaches the target.
+func EndAtScore(target int) PhaseController {
+	return func(game *Game) Phase {
+		for _, s := range game.Seats {
+			if s.Score >= target {
+				return EndPhase
+			}
+		}
+		return MainPhase
+	}
+}
+
+// EndOnAny returns a PhaseController which composes the given controllers,
+// ending the game if any of them would. The controllers are consulted in
+// order, and the first phase other than MainPhase is returned.
+func EndOnAny(controllers ...PhaseController) PhaseController {
+	return func(game *Game) Phase {
+		for _, c := range controllers {
+			if next := c(game); next != MainPhase {
+				return next
+			}
+		}
+		return MainPhase
+	}
+}
+
+// EndOnRackEmptied implements a PhaseController which ends the game when the
+// player who took the last turn has an empty rack, meaning that they played
+// out and their rack couldn't be replenished from the bag.
+func EndOnRackEmptied(game *Game) Phase {
+	if len(game.History) == 0 {
+		return MainPhase
+	}
+
+	lastTurn := game.History.Last()
+	if len(game.Seats[lastTurn.SeatIndex].Rack) == 0 {
+		return EndPhase
+	}
 	return MainPhase
 }
+
+// NextPhase implements a PhaseController with the default game progression
+// and ending conditions: the game ends when a player plays out, or after
+// MaxScorelessTurns consecutive scoreless turns.
+func NextPhase(game *Game) Phase {
+	return defaultPhaseController(game)
+}
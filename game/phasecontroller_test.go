@@ -2,12 +2,221 @@ package game
 
 import (
 	"testing"
+	"time"
 
 	"github.com/mandykoh/scrubble/history"
 	"github.com/mandykoh/scrubble/seat"
 	"github.com/mandykoh/scrubble/tile"
 )
 
+func TestEndAfterEachPlayerPasses(t *testing.T) {
+
+	t.Run("ends the game once every player has passed on their last n turns", func(t *testing.T) {
+		game := &Game{
+			Seats: []seat.Seat{{}, {}},
+			History: history.History{
+				{Type: history.PlayEntryType, Score: 10},
+				{Type: history.PassEntryType, SeatIndex: 1},
+				{Type: history.PassEntryType},
+				{Type: history.PassEntryType, SeatIndex: 1},
+			},
+		}
+		controller := EndAfterEachPlayerPasses(2)
+
+		if actual, expected := controller(game), MainPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+
+		game.History.AppendPass(0)
+
+		if actual, expected := controller(game), EndPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+	})
+
+	t.Run("does not count exchanges or successfully challenged plays as passes", func(t *testing.T) {
+		game := &Game{
+			Seats: []seat.Seat{{}, {}},
+			History: history.History{
+				{Type: history.PassEntryType},
+				{Type: history.PlayEntryType, SeatIndex: 1, Score: 20},
+				{Type: history.ChallengeSuccessEntryType},
+				{Type: history.PassEntryType},
+				{Type: history.ExchangeTilesEntryType, SeatIndex: 1},
+				{Type: history.PassEntryType},
+				{Type: history.PassEntryType, SeatIndex: 1},
+			},
+		}
+		controller := EndAfterEachPlayerPasses(2)
+
+		if actual, expected := controller(game), MainPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+	})
+
+	t.Run("ignores failed challenges", func(t *testing.T) {
+		game := &Game{
+			Seats: []seat.Seat{{}, {}},
+			History: history.History{
+				{Type: history.PassEntryType},
+				{Type: history.PassEntryType, SeatIndex: 1},
+				{Type: history.ChallengeFailEntryType},
+				{Type: history.PassEntryType},
+				{Type: history.PassEntryType, SeatIndex: 1},
+			},
+		}
+		controller := EndAfterEachPlayerPasses(2)
+
+		if actual, expected := controller(game), EndPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+	})
+}
+
+func TestEndAfterScorelessTurns(t *testing.T) {
+
+	t.Run("ends the game after n consecutive scoreless turns", func(t *testing.T) {
+		game := &Game{
+			Seats: []seat.Seat{{}, {}},
+			History: history.History{
+				{Type: history.PlayEntryType, Score: 10},
+				{Type: history.PassEntryType, SeatIndex: 1},
+				{Type: history.ExchangeTilesEntryType},
+			},
+		}
+		controller := EndAfterScorelessTurns(3)
+
+		if actual, expected := controller(game), MainPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+
+		game.History.AppendPass(1)
+
+		if actual, expected := controller(game), EndPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+	})
+}
+
+func TestEndAfterTurns(t *testing.T) {
+
+	t.Run("ends the game once n turns have been taken, not counting challenges", func(t *testing.T) {
+		game := &Game{
+			Seats: []seat.Seat{{}, {}},
+			History: history.History{
+				{Type: history.PlayEntryType, Score: 10},
+				{Type: history.ChallengeFailEntryType, SeatIndex: 1},
+				{Type: history.ExchangeTilesEntryType, SeatIndex: 1},
+				{Type: history.PlayEntryType, Score: 12},
+				{Type: history.ChallengeSuccessEntryType, SeatIndex: 1},
+			},
+		}
+		controller := EndAfterTurns(4)
+
+		if actual, expected := controller(game), MainPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+
+		game.History.AppendPass(1)
+
+		if actual, expected := controller(game), EndPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+	})
+}
+
+func TestEndAtDeadline(t *testing.T) {
+	deadline := time.Date(2020, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	clock := func(t time.Time) func() time.Time {
+		return func() time.Time { return t }
+	}
+
+	t.Run("allows the game to continue before the deadline", func(t *testing.T) {
+		controller := EndAtDeadline(deadline, clock(deadline.Add(-time.Nanosecond)))
+
+		if actual, expected := controller(&Game{}), MainPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+	})
+
+	t.Run("ends the game once the deadline is reached", func(t *testing.T) {
+		for _, now := range []time.Time{deadline, deadline.Add(time.Minute)} {
+			controller := EndAtDeadline(deadline, clock(now))
+
+			if actual, expected := controller(&Game{}), EndPhase; actual != expected {
+				t.Errorf("Expected %#v at %v but got %#v", expected, now, actual)
+			}
+		}
+	})
+}
+
+func TestEndAtScore(t *testing.T) {
+
+	t.Run("ends the game once any player reaches the target score", func(t *testing.T) {
+		game := &Game{
+			Seats: []seat.Seat{{Score: 99}, {Score: 42}},
+		}
+		controller := EndAtScore(100)
+
+		if actual, expected := controller(game), MainPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+
+		game.Seats[1].Score = 100
+
+		if actual, expected := controller(game), EndPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+	})
+}
+
+func TestEndOnAny(t *testing.T) {
+	continuing := func(*Game) Phase { return MainPhase }
+	ending := func(*Game) Phase { return EndPhase }
+
+	t.Run("allows the game to continue when no controller ends it", func(t *testing.T) {
+		controller := EndOnAny(continuing, continuing)
+
+		if actual, expected := controller(&Game{}), MainPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+	})
+
+	t.Run("ends the game when any controller ends it", func(t *testing.T) {
+		controller := EndOnAny(continuing, ending, continuing)
+
+		if actual, expected := controller(&Game{}), EndPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+	})
+}
+
+func TestEndOnRackEmptied(t *testing.T) {
+
+	t.Run("allows the game to continue when no turns have been taken", func(t *testing.T) {
+		game := &Game{Seats: []seat.Seat{{}}}
+
+		if actual, expected := EndOnRackEmptied(game), MainPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+	})
+
+	t.Run("ends the game when the last player's rack is empty", func(t *testing.T) {
+		game := &Game{
+			Seats: []seat.Seat{
+				{Rack: tile.Rack{tile.Make('A', 1)}},
+				{Rack: tile.Rack{}},
+			},
+			History: history.History{{Type: history.PlayEntryType, SeatIndex: 1, Score: 10}},
+		}
+
+		if actual, expected := EndOnRackEmptied(game), EndPhase; actual != expected {
+			t.Errorf("Expected %#v but got %#v", expected, actual)
+		}
+	})
+}
+
 func TestNextPhase(t *testing.T) {
 
 	t.Run("allows the game to continue when the rack could be replenished with at least one tile", func(t *testing.T) {
@@ -15,7 +224,7 @@ func TestNextPhase(t *testing.T) {
 			Seats: []seat.Seat{
 				{
 					Rack: tile.Rack{
-						{'A', 1},
+						tile.Make('A', 1),
 					},
 				},
 			},
@@ -47,8 +256,8 @@ func TestNextPhase(t *testing.T) {
 	t.Run("ends the game after six consecutive scoreless turns", func(t *testing.T) {
 		game := &Game{
 			Seats: []seat.Seat{
-				{Rack: tile.Rack{{'A', 1}}},
-				{Rack: tile.Rack{{'B', 2}}},
+				{Rack: tile.Rack{tile.Make('A', 1)}},
+				{Rack: tile.Rack{tile.Make('B', 2)}},
 			},
 			History: history.History{
 				{Type: history.PassEntryType},
@@ -76,8 +285,8 @@ func TestNextPhase(t *testing.T) {
 	t.Run("treats successfully challenged plays as scoreless turns", func(t *testing.T) {
 		game := &Game{
 			Seats: []seat.Seat{
-				{Rack: tile.Rack{{'A', 1}}},
-				{Rack: tile.Rack{{'B', 2}}},
+				{Rack: tile.Rack{tile.Make('A', 1)}},
+				{Rack: tile.Rack{tile.Make('B', 2)}},
 			},
 			History: history.History{
 				{Type: history.PlayEntryType, Score: 123},
@@ -4,7 +4,9 @@ import (
 	"github.com/mandykoh/scrubble/board"
 	"github.com/mandykoh/scrubble/challenge"
 	"github.com/mandykoh/scrubble/dict"
+	"github.com/mandykoh/scrubble/exchange"
 	"github.com/mandykoh/scrubble/history"
+	"github.com/mandykoh/scrubble/movegen"
 	"github.com/mandykoh/scrubble/play"
 	"github.com/mandykoh/scrubble/scoring"
 	"github.com/mandykoh/scrubble/seat"
@@ -18,13 +20,20 @@ import (
 // when a play is challenged, rather than automatically upon word scoring).
 type Rules struct {
 	dictionary          dict.Dictionary
+	firstPlayValidator  play.FirstPlayValidator
 	gamePhaseController PhaseController
+	moveGenerator       *movegen.Generator
 	placementValidator  play.PlacementValidator
 	rackValidator       tile.RackValidator
 	challengeValidator  challenge.Validator
 	wordScorer          scoring.WordScorer
 	endGameScorer       scoring.EndGameScorer
+	exchangeValidator   exchange.Validator
+	bonusSchedule       scoring.BonusSchedule
+	fullRackBonus       int
+	rackSize            int
 	useDictForScoring   bool
+	reportAllViolations bool
 }
 
 // ValidateChallenge determines if a challenge to a play is legal, and whether
@@ -43,6 +52,34 @@ func (r *Rules) ValidateChallenge(lastPlay *history.Entry) (success bool, err er
 	return validateChallenge(lastPlay, dictionary)
 }
 
+// MoveGenerator returns the generator used to find the plays available to a
+// player (eg for hints) in a game using tiles with the specified alphabet.
+// Candidate plays are validated and scored according to these Rules, so any
+// play which ValidatePlacements or ScoreWords would reject is left out.
+//
+// Unless overridden by WithMoveGenerator, a new generator is built which finds
+// plays using the default English word list, split into tiles using the given
+// alphabet. This is expensive, so callers should keep the generator rather
+// than asking for it again for each search. Since other dictionaries can't be
+// enumerated, if the dictionary has been overridden by WithDictionary then a
+// generator must be given, and otherwise MoveGeneratorRequiredError is
+// returned.
+func (r *Rules) MoveGenerator(alphabet tile.Alphabet) (*movegen.Generator, error) {
+	gen := r.moveGenerator
+	if gen == nil {
+		if r.dictionary != nil {
+			return nil, MoveGeneratorRequiredError{}
+		}
+		gen = movegen.New(dict.DefaultEnglishWordList(), alphabet)
+	}
+
+	return gen.
+		WithPlacementValidator(r.ValidatePlacements).
+		WithWordScorer(func(placements play.Tiles, b *board.Board, isWordValid dict.Dictionary) (int, []play.Word, error) {
+			return r.ScoreWords(placements, b)
+		}), nil
+}
+
 // NextGamePhase determines the next game phase given the game's current state.
 // Unless overridden by WithGamePhaseController, this uses the default
 // implementation provided by the game.NextPhase function.
@@ -56,6 +93,15 @@ func (r *Rules) NextGamePhase(g *Game) Phase {
 	return nextGamePhase(g)
 }
 
+// RackSize returns the number of tiles that each player's rack is filled to.
+// Unless overridden by WithRackSize, this is tile.MaxRackTiles.
+func (r *Rules) RackSize() int {
+	if r.rackSize == 0 {
+		return tile.MaxRackTiles
+	}
+	return r.rackSize
+}
+
 // ScoreEndGame determines the final scores to be added to each player's total
 // after the last play of the game is made.
 func (r *Rules) ScoreEndGame(lastPlay *history.Entry, seats []seat.Seat) (finalScores []int) {
@@ -69,9 +115,11 @@ func (r *Rules) ScoreEndGame(lastPlay *history.Entry, seats []seat.Seat) (finalS
 // ScoreWords determines the scoring from a set of proposed tile placements.
 // This assumes that the tiles are being placed in valid positions according to
 // placement validation. Unless overridden by WithWordScorer, this uses the
-// default implementation provided by the scoring.ScoreWords function. If
-// WithDictionaryForScoring is set to true, words are validated against the
-// current dictionary.
+// default implementation provided by the scoring.ScoreWords function, with
+// bonus points awarded according to WithBonusSchedule (or as given by
+// WithFullRackBonus for playing a full rack of RackSize tiles, if no schedule
+// is given). If WithDictionaryForScoring is set to true, words are validated
+// against the current dictionary.
 //
 // If a score cannot be determined because not all formed words are valid, an
 // InvalidWordError is returned containing the invalid words.
@@ -88,24 +136,63 @@ func (r *Rules) ScoreWords(placements play.Tiles, board *board.Board) (score int
 
 	wordScorer := r.wordScorer
 	if wordScorer == nil {
-		wordScorer = scoring.ScoreWords
+		schedule := r.bonusSchedule
+		if schedule == nil {
+			bonus := r.fullRackBonus
+			if bonus == 0 {
+				bonus = scoring.MaxRackTilesBonus
+			}
+			schedule = scoring.FullRackBonus(r.RackSize(), bonus)
+		}
+		wordScorer = scoring.ScoreWordsWithSchedule(schedule)
 	}
 	return wordScorer(placements, board, dictionary)
 }
 
+// ValidateExchange determines whether an attempt to exchange tiles is legal,
+// and how many points it costs the player. Unless overridden by
+// WithExchangeValidator, this uses the default implementation provided by the
+// exchange.Validate function, requiring at least RackSize tiles in the bag.
+//
+// The attempt's RackSize is always set to RackSize before it is validated.
+func (r *Rules) ValidateExchange(attempt exchange.Attempt) (cost int, err error) {
+	attempt.RackSize = r.RackSize()
+
+	validateExchange := r.exchangeValidator
+	if validateExchange == nil {
+		validateExchange = exchange.Validate
+	}
+	return validateExchange(attempt)
+}
+
 // ValidatePlacements checks the intended placement of tiles on a board for
 // legality. Unless overridden by WithPlacementValidator, this uses the default
-// implementation provided by the play.ValidatePlacements function.
+// implementation provided by the play.ValidatePlacements function, or by
+// play.ValidatePlacementsWithFirstPlay if WithFirstPlayValidator has been used
+// to specify rules for the first play of the game.
 //
 // If any violations are detected, InvalidTilePlacementError is returned with
-// the reason indicating the violation.
+// the reason indicating the violation. If WithAllPlacementViolations is set to
+// true, the default implementations are instead provided by
+// play.ValidateAllPlacements and play.ValidateAllPlacementsWithFirstPlay, and
+// PlacementViolationsError is returned describing every violation.
 //
 // Otherwise, nil is returned, indicating that it would be safe to place the
 // given tiles on the board (word validity not withstanding).
 func (r *Rules) ValidatePlacements(placements play.Tiles, b *board.Board) error {
 	placementValidator := r.placementValidator
 	if placementValidator == nil {
-		placementValidator = play.ValidatePlacements
+		if r.reportAllViolations {
+			if r.firstPlayValidator != nil {
+				placementValidator = play.ValidateAllPlacementsWithFirstPlay(r.firstPlayValidator)
+			} else {
+				placementValidator = play.ValidateAllPlacements
+			}
+		} else if r.firstPlayValidator != nil {
+			placementValidator = play.ValidatePlacementsWithFirstPlay(r.firstPlayValidator)
+		} else {
+			placementValidator = play.ValidatePlacements
+		}
 	}
 	return placementValidator(placements, b)
 }
@@ -128,6 +215,27 @@ func (r *Rules) ValidateTilesFromRack(rack tile.Rack, toPlay []tile.Tile) (used,
 	return rackValidator(rack, toPlay)
 }
 
+// WithAllPlacementViolations returns a copy of these Rules which optionally
+// reports every violation found when validating the placement of tiles, along
+// with the coordinates involved, instead of only the first. This allows UIs to
+// highlight each problem with a play. It only applies when placements are
+// validated by the default placement validator.
+func (r Rules) WithAllPlacementViolations(report bool) Rules {
+	r.reportAllViolations = report
+	return r
+}
+
+// WithBonusSchedule returns a copy of these Rules which awards bonus points
+// for plays according to the specified schedule, such as
+// scoring.TieredBonus. The default is to award scoring.MaxRackTilesBonus
+// points (unless overridden by WithFullRackBonus) for playing a full rack of
+// RackSize tiles. This only applies when words are scored by the default word
+// scorer.
+func (r Rules) WithBonusSchedule(schedule scoring.BonusSchedule) Rules {
+	r.bonusSchedule = schedule
+	return r
+}
+
 // WithChallengeValidator returns a copy of these Rules which uses the
 // specified function for determining the success or failure of challenges.
 func (r Rules) WithChallengeValidator(validator challenge.Validator) Rules {
@@ -159,6 +267,36 @@ func (r Rules) WithEndGameScorer(scorer scoring.EndGameScorer) Rules {
 	return r
 }
 
+// WithExchangeValidator returns a copy of these Rules which uses the specified
+// function for validating tile exchanges, such as a combination of the
+// policies provided by the exchange package.
+func (r Rules) WithExchangeValidator(validator exchange.Validator) Rules {
+	r.exchangeValidator = validator
+	return r
+}
+
+// WithFirstPlayValidator returns a copy of these Rules which uses the specified
+// function for validating the placement of tiles for the first play of the
+// game, such as play.FirstPlayOnStart or play.FirstPlayAnywhere. The default is
+// to require that the first play covers a starting position.
+//
+// This only applies when placements are validated by the default placement
+// validator; a validator set with WithPlacementValidator is responsible for its
+// own first play rules.
+func (r Rules) WithFirstPlayValidator(validator play.FirstPlayValidator) Rules {
+	r.firstPlayValidator = validator
+	return r
+}
+
+// WithFullRackBonus returns a copy of these Rules which awards the specified
+// number of bonus points for playing a full rack of RackSize tiles, instead of
+// scoring.MaxRackTilesBonus. This only applies when no bonus schedule is given
+// by WithBonusSchedule.
+func (r Rules) WithFullRackBonus(points int) Rules {
+	r.fullRackBonus = points
+	return r
+}
+
 // WithGamePhaseController returns a copy of these Rules which uses the
 // specified function for determining the progression of the game, and the
 // conditions under which the game ends.
@@ -167,6 +305,15 @@ func (r Rules) WithGamePhaseController(controller PhaseController) Rules {
 	return r
 }
 
+// WithMoveGenerator returns a copy of these Rules which uses the specified
+// generator to find the plays available to a player (eg for hints). The
+// generator should use the same words as the dictionary, and the alphabet of
+// the game's tiles. This is required if the dictionary has been overridden.
+func (r Rules) WithMoveGenerator(gen *movegen.Generator) Rules {
+	r.moveGenerator = gen
+	return r
+}
+
 // WithPlacementValidator returns a copy of these Rules which uses the specified
 // function for tile placement validation.
 func (r Rules) WithPlacementValidator(validator play.PlacementValidator) Rules {
@@ -174,6 +321,16 @@ func (r Rules) WithPlacementValidator(validator play.PlacementValidator) Rules {
 	return r
 }
 
+// WithRackSize returns a copy of these Rules which fills each player's rack to
+// the specified number of tiles, instead of tile.MaxRackTiles. This also
+// determines the number of tiles needed in the bag to exchange tiles (unless
+// overridden by WithExchangeValidator), and for a play to receive the full
+// rack bonus (unless overridden by WithBonusSchedule).
+func (r Rules) WithRackSize(size int) Rules {
+	r.rackSize = size
+	return r
+}
+
 // WithRackValidator returns a copy of these Rules which uses the specified
 // function for tile rack validation.
 func (r Rules) WithRackValidator(validator tile.RackValidator) Rules {
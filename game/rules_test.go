@@ -6,8 +6,10 @@ import (
 	"github.com/mandykoh/scrubble/board"
 	"github.com/mandykoh/scrubble/coord"
 	"github.com/mandykoh/scrubble/dict"
+	"github.com/mandykoh/scrubble/exchange"
 	"github.com/mandykoh/scrubble/history"
 	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/scoring"
 	"github.com/mandykoh/scrubble/seat"
 	"github.com/mandykoh/scrubble/tile"
 )
@@ -95,6 +97,23 @@ func TestRules(t *testing.T) {
 			rules.ValidateChallenge(&history.Entry{})
 		})
 
+		t.Run("has a standard rack size", func(t *testing.T) {
+			if actual, expected := rules.RackSize(), tile.MaxRackTiles; actual != expected {
+				t.Errorf("Expected rack size of %d but got %d", expected, actual)
+			}
+		})
+
+		t.Run("can validate exchanges", func(t *testing.T) {
+			cost, err := rules.ValidateExchange(exchange.Attempt{BagTiles: tile.MaxRackTiles})
+
+			if err != nil {
+				t.Errorf("Expected success but got error %v", err)
+			}
+			if actual, expected := cost, 0; actual != expected {
+				t.Errorf("Expected exchange to cost %d but got %d", expected, actual)
+			}
+		})
+
 		t.Run("can validate placements", func(t *testing.T) {
 			defer func() {
 				if r := recover(); r != nil {
@@ -116,6 +135,72 @@ func TestRules(t *testing.T) {
 		})
 	})
 
+	t.Run(".WithAllPlacementViolations()", func(t *testing.T) {
+		overriddenRules := Rules{}.WithAllPlacementViolations(true)
+		b := board.WithStandardLayout()
+		b.Position(coord.Make(0, 0)).Tile = &tile.Tile{Letter: "A", Points: 1}
+
+		invalidPlacements := play.Tiles{
+			{tile.Make('A', 1), coord.Make(0, 0)},
+			{tile.Make('B', 1), coord.Make(0, 2)},
+		}
+
+		t.Run("reports every placement violation", func(t *testing.T) {
+			err := overriddenRules.ValidatePlacements(invalidPlacements, &b)
+
+			if e, ok := err.(play.PlacementViolationsError); !ok {
+				t.Errorf("Expected a PlacementViolationsError but got %v", err)
+			} else if actual, expected := len(e.Violations), 2; actual != expected {
+				t.Errorf("Expected %d violations but got %v", expected, e.Violations)
+			}
+		})
+
+		t.Run("applies the first play validator", func(t *testing.T) {
+			empty := board.WithStandardLayout()
+			r := overriddenRules.WithFirstPlayValidator(play.FirstPlayAnywhere)
+
+			err := r.ValidatePlacements(play.Tiles{{tile.Make('A', 1), coord.Make(0, 0)}}, &empty)
+
+			if err != nil {
+				t.Errorf("Expected success but got error %v", err)
+			}
+		})
+
+		t.Run("leaves the original rules unmodified", func(t *testing.T) {
+			err := rules.ValidatePlacements(invalidPlacements, &b)
+
+			if actual, expected := err, (play.InvalidTilePlacementError{Reason: play.PositionOccupiedReason}); actual != expected {
+				t.Errorf("Expected original rules to report %v but got %v", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".WithBonusSchedule()", func(t *testing.T) {
+		overriddenRules := Rules{}.WithBonusSchedule(func(tilesPlayed int) int { return tilesPlayed * 100 })
+
+		t.Run("sets the schedule to use for bonus points", func(t *testing.T) {
+			b := board.WithStandardLayout()
+
+			score, _, err := overriddenRules.ScoreWords(play.Tiles{
+				{tile.Make('A', 1), coord.Make(7, 7)},
+				{tile.Make('T', 1), coord.Make(7, 8)},
+			}, &b)
+
+			if err != nil {
+				t.Fatalf("Expected success but got error %v", err)
+			}
+			if actual, expected := score, 4+200; actual != expected {
+				t.Errorf("Expected score of %d but got %d", expected, actual)
+			}
+		})
+
+		t.Run("leaves the original rules unmodified", func(t *testing.T) {
+			if actual := rules.bonusSchedule; actual != nil {
+				t.Errorf("Expected original bonus schedule to be unmodified but wasn't")
+			}
+		})
+	})
+
 	t.Run(".WithChallengeValidator()", func(t *testing.T) {
 		validatorCalled := 0
 		validator := func(*history.Entry, dict.Dictionary) (bool, error) {
@@ -214,6 +299,105 @@ func TestRules(t *testing.T) {
 		})
 	})
 
+	t.Run(".WithExchangeValidator()", func(t *testing.T) {
+		validatorCalled := 0
+		validator := func(exchange.Attempt) (int, error) {
+			validatorCalled++
+			return 3, nil
+		}
+
+		overriddenRules := Rules{}.WithExchangeValidator(validator)
+
+		t.Run("sets the validator to use for exchange validation", func(t *testing.T) {
+			cost, _ := overriddenRules.ValidateExchange(exchange.Attempt{})
+
+			if actual, expected := validatorCalled, 1; actual != expected {
+				t.Errorf("Expected overridden validator to be called once but got %d invocations", actual)
+			}
+			if actual, expected := cost, 3; actual != expected {
+				t.Errorf("Expected exchange to cost %d but got %d", expected, actual)
+			}
+		})
+
+		t.Run("leaves the original rules unmodified", func(t *testing.T) {
+			if actual := rules.exchangeValidator; actual != nil {
+				t.Errorf("Expected original exchange validator to be unmodified but wasn't")
+			}
+		})
+	})
+
+	t.Run(".WithFirstPlayValidator()", func(t *testing.T) {
+		validatorCalled := 0
+		validator := func(play.Tiles, *board.Board) error {
+			validatorCalled++
+			return nil
+		}
+
+		overriddenRules := Rules{}.WithFirstPlayValidator(validator)
+
+		t.Run("sets the validator to use for first play validation", func(t *testing.T) {
+			validatorCalled = 0
+			b := board.WithStandardLayout()
+
+			err := overriddenRules.ValidatePlacements(play.Tiles{
+				{tile.Make('A', 1), coord.Make(0, 0)},
+				{tile.Make('B', 1), coord.Make(0, 1)},
+			}, &b)
+
+			if err != nil {
+				t.Errorf("Expected success but got error %v", err)
+			}
+			if actual, expected := validatorCalled, 1; actual != expected {
+				t.Errorf("Expected overridden validator to be called once but got %d invocations", actual)
+			}
+		})
+
+		t.Run("is not used when a placement validator is overridden", func(t *testing.T) {
+			validatorCalled = 0
+			b := board.WithStandardLayout()
+
+			r := overriddenRules.WithPlacementValidator(func(play.Tiles, *board.Board) error { return nil })
+			r.ValidatePlacements(play.Tiles{{tile.Make('A', 1), coord.Make(0, 0)}}, &b)
+
+			if actual, expected := validatorCalled, 0; actual != expected {
+				t.Errorf("Expected overridden validator to remain unused but got %d invocations", actual)
+			}
+		})
+
+		t.Run("leaves the original rules unmodified", func(t *testing.T) {
+			if actual := rules.firstPlayValidator; actual != nil {
+				t.Errorf("Expected original first play validator to be unmodified but wasn't")
+			}
+		})
+	})
+
+	t.Run(".WithFullRackBonus()", func(t *testing.T) {
+		overriddenRules := Rules{}.WithFullRackBonus(35)
+
+		t.Run("sets the bonus for playing a full rack of RackSize tiles", func(t *testing.T) {
+			b := board.WithStandardLayout()
+			smallRackRules := overriddenRules.WithRackSize(2)
+
+			score, _, err := smallRackRules.ScoreWords(play.Tiles{
+				{tile.Make('A', 1), coord.Make(7, 7)},
+				{tile.Make('T', 1), coord.Make(7, 8)},
+			}, &b)
+
+			if err != nil {
+				t.Fatalf("Expected success but got error %v", err)
+			}
+			if actual, expected := score, 4+35; actual != expected {
+				t.Errorf("Expected score of %d but got %d", expected, actual)
+			}
+		})
+
+		t.Run("leaves the original rules unmodified", func(t *testing.T) {
+			if actual, expected := rules.fullRackBonus, 0; actual != expected {
+				t.Errorf("Expected original full rack bonus to be unmodified but was %d", actual)
+			}
+		})
+	})
+
 	t.Run(".WithGamePhaseController()", func(t *testing.T) {
 		controllerCalled := 0
 		controller := func(*Game) Phase {
@@ -262,6 +446,58 @@ func TestRules(t *testing.T) {
 		})
 	})
 
+	t.Run(".WithRackSize()", func(t *testing.T) {
+		overriddenRules := Rules{}.WithRackSize(8)
+
+		t.Run("sets the rack size", func(t *testing.T) {
+			if actual, expected := overriddenRules.RackSize(), 8; actual != expected {
+				t.Errorf("Expected rack size of %d but got %d", expected, actual)
+			}
+		})
+
+		t.Run("requires a full rack of tiles in the bag for exchanges", func(t *testing.T) {
+			_, err := overriddenRules.ValidateExchange(exchange.Attempt{BagTiles: 7})
+
+			if actual, expected := err, (exchange.InvalidTileExchangeError{Reason: exchange.InsufficientTilesInBagReason}); actual != expected {
+				t.Errorf("Expected error %v but got %v", expected, actual)
+			}
+		})
+
+		t.Run("awards the full rack bonus only for playing the whole rack", func(t *testing.T) {
+			b := board.WithStandardLayout()
+			placements := play.Tiles{
+				{tile.Make('A', 1), coord.Make(7, 0)},
+				{tile.Make('B', 1), coord.Make(7, 1)},
+				{tile.Make('C', 1), coord.Make(7, 2)},
+				{tile.Make('D', 1), coord.Make(7, 3)},
+				{tile.Make('E', 1), coord.Make(7, 4)},
+				{tile.Make('F', 1), coord.Make(7, 5)},
+				{tile.Make('G', 1), coord.Make(7, 6)},
+				{tile.Make('H', 1), coord.Make(7, 7)},
+			}
+
+			standardScore, _, _ := rules.ScoreWords(placements[:7], &b)
+			score, _, _ := overriddenRules.ScoreWords(placements[:7], &b)
+
+			if actual, expected := score, standardScore-scoring.MaxRackTilesBonus; actual != expected {
+				t.Errorf("Expected score of %d for seven tiles but got %d", expected, actual)
+			}
+
+			standardScore, _, _ = rules.ScoreWords(placements, &b)
+			score, _, _ = overriddenRules.ScoreWords(placements, &b)
+
+			if actual, expected := score, standardScore; actual != expected {
+				t.Errorf("Expected score of %d for eight tiles but got %d", expected, actual)
+			}
+		})
+
+		t.Run("leaves the original rules unmodified", func(t *testing.T) {
+			if actual, expected := rules.RackSize(), tile.MaxRackTiles; actual != expected {
+				t.Errorf("Expected original rack size to be unmodified but was %d", actual)
+			}
+		})
+	})
+
 	t.Run(".WithRackValidator()", func(t *testing.T) {
 		validatorCalled := 0
 		validator := func(tile.Rack, []tile.Tile) ([]tile.Tile, []tile.Tile, error) {
@@ -4,4 +4,5 @@ require (
 	github.com/buger/goterm v0.0.0-20180307092342-c9def0117b24
 	github.com/mandykoh/go-bump v0.1.3
 	golang.org/x/sys v0.0.0-20180420145319-79b0c6888797 // indirect
+	golang.org/x/text v0.3.0
 )
@@ -61,3 +61,19 @@ func (h *History) AppendPlay(seatIndex int, score int, tilesSpent []tile.Tile, t
 func (h *History) Last() *Entry {
 	return &(*h)[len(*h)-1]
 }
+
+// Withdrawn returns whether the play at the specified index was withdrawn
+// because it was successfully challenged, allowing for any unsuccessful
+// challenges made before it.
+func (h History) Withdrawn(playIndex int) bool {
+	for _, e := range h[playIndex+1:] {
+		switch e.Type {
+		case ChallengeSuccessEntryType:
+			return true
+		case ChallengeFailEntryType:
+			continue
+		}
+		return false
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package history
+
+import "testing"
+
+func TestHistory(t *testing.T) {
+
+	t.Run(".Withdrawn()", func(t *testing.T) {
+		var h History
+		h.AppendPlay(0, 10, nil, nil, nil, nil)
+		h.AppendChallengeSuccess(1)
+		h.AppendPlay(1, 12, nil, nil, nil, nil)
+		h.AppendChallengeFail(0)
+		h.AppendPlay(0, 8, nil, nil, nil, nil)
+		h.AppendChallengeFail(1)
+		h.AppendChallengeSuccess(0)
+		h.AppendPlay(1, 20, nil, nil, nil, nil)
+		h.AppendPass(0)
+		h.AppendChallengeSuccess(1)
+		h.AppendPlay(0, 6, nil, nil, nil, nil)
+
+		cases := []struct {
+			Index    int
+			Expected bool
+		}{
+			{0, true},
+			{2, false},
+			{4, true},
+			{7, false},
+			{10, false},
+		}
+
+		for _, c := range cases {
+			if actual := h.Withdrawn(c.Index); actual != c.Expected {
+				t.Errorf("Expected play %d to be withdrawn: %v but got %v", c.Index, c.Expected, actual)
+			}
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package locale
+
+import (
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// Dutch returns the preset for Dutch, with 102 tiles (including 2 blanks).
+func Dutch() Locale {
+	return Locale{
+		Code: "nl",
+		Name: "Dutch",
+		Distribution: tile.Distribution{
+			{Tile: tile.MakeBlank(), Count: 2},
+			{Tile: tile.Make('E', 1), Count: 18},
+			{Tile: tile.Make('N', 1), Count: 10},
+			{Tile: tile.Make('A', 1), Count: 6},
+			{Tile: tile.Make('O', 1), Count: 6},
+			{Tile: tile.Make('I', 1), Count: 4},
+			{Tile: tile.Make('D', 2), Count: 5},
+			{Tile: tile.Make('R', 2), Count: 5},
+			{Tile: tile.Make('S', 2), Count: 5},
+			{Tile: tile.Make('T', 2), Count: 5},
+			{Tile: tile.Make('G', 3), Count: 3},
+			{Tile: tile.Make('K', 3), Count: 3},
+			{Tile: tile.Make('L', 3), Count: 3},
+			{Tile: tile.Make('M', 3), Count: 3},
+			{Tile: tile.Make('B', 3), Count: 2},
+			{Tile: tile.Make('P', 3), Count: 2},
+			{Tile: tile.Make('U', 4), Count: 3},
+			{Tile: tile.Make('F', 4), Count: 2},
+			{Tile: tile.Make('H', 4), Count: 2},
+			{Tile: tile.Make('J', 4), Count: 2},
+			{Tile: tile.Make('V', 4), Count: 2},
+			{Tile: tile.Make('Z', 4), Count: 2},
+			{Tile: tile.Make('C', 5), Count: 2},
+			{Tile: tile.Make('W', 5), Count: 2},
+			{Tile: tile.Make('X', 8), Count: 1},
+			{Tile: tile.Make('Y', 8), Count: 1},
+			{Tile: tile.Make('Q', 10), Count: 1},
+		},
+		Layout: board.StandardLayout(),
+	}
+}
@@ -0,0 +1,27 @@
+package locale
+
+import (
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// English returns the preset for English, with 100 tiles (including 2 blanks).
+func English() Locale {
+	return Locale{
+		Code:         "en",
+		Name:         "English",
+		Distribution: tile.StandardEnglishDistribution(),
+		Layout:       board.StandardLayout(),
+	}
+}
+
+// EnglishSuper returns the preset for the English super variant, with 200 tiles
+// (including 4 blanks) and a 21x21 board.
+func EnglishSuper() Locale {
+	return Locale{
+		Code:         "en-super",
+		Name:         "English (Super)",
+		Distribution: tile.SuperEnglishDistribution(),
+		Layout:       board.SuperLayout(),
+	}
+}
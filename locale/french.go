@@ -0,0 +1,44 @@
+package locale
+
+import (
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// French returns the preset for French, with 102 tiles (including 2 blanks).
+func French() Locale {
+	return Locale{
+		Code: "fr",
+		Name: "French",
+		Distribution: tile.Distribution{
+			{Tile: tile.MakeBlank(), Count: 2},
+			{Tile: tile.Make('E', 1), Count: 15},
+			{Tile: tile.Make('A', 1), Count: 9},
+			{Tile: tile.Make('I', 1), Count: 8},
+			{Tile: tile.Make('N', 1), Count: 6},
+			{Tile: tile.Make('O', 1), Count: 6},
+			{Tile: tile.Make('R', 1), Count: 6},
+			{Tile: tile.Make('S', 1), Count: 6},
+			{Tile: tile.Make('T', 1), Count: 6},
+			{Tile: tile.Make('U', 1), Count: 6},
+			{Tile: tile.Make('L', 1), Count: 5},
+			{Tile: tile.Make('D', 2), Count: 3},
+			{Tile: tile.Make('M', 2), Count: 3},
+			{Tile: tile.Make('G', 2), Count: 2},
+			{Tile: tile.Make('B', 3), Count: 2},
+			{Tile: tile.Make('C', 3), Count: 2},
+			{Tile: tile.Make('P', 3), Count: 2},
+			{Tile: tile.Make('F', 4), Count: 2},
+			{Tile: tile.Make('H', 4), Count: 2},
+			{Tile: tile.Make('V', 4), Count: 2},
+			{Tile: tile.Make('J', 8), Count: 1},
+			{Tile: tile.Make('Q', 8), Count: 1},
+			{Tile: tile.Make('K', 10), Count: 1},
+			{Tile: tile.Make('W', 10), Count: 1},
+			{Tile: tile.Make('X', 10), Count: 1},
+			{Tile: tile.Make('Y', 10), Count: 1},
+			{Tile: tile.Make('Z', 10), Count: 1},
+		},
+		Layout: board.StandardLayout(),
+	}
+}
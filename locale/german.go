@@ -0,0 +1,47 @@
+package locale
+
+import (
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// German returns the preset for German, with 102 tiles (including 2 blanks).
+func German() Locale {
+	return Locale{
+		Code: "de",
+		Name: "German",
+		Distribution: tile.Distribution{
+			{Tile: tile.MakeBlank(), Count: 2},
+			{Tile: tile.Make('E', 1), Count: 15},
+			{Tile: tile.Make('N', 1), Count: 9},
+			{Tile: tile.Make('S', 1), Count: 7},
+			{Tile: tile.Make('I', 1), Count: 6},
+			{Tile: tile.Make('R', 1), Count: 6},
+			{Tile: tile.Make('T', 1), Count: 6},
+			{Tile: tile.Make('U', 1), Count: 6},
+			{Tile: tile.Make('A', 1), Count: 5},
+			{Tile: tile.Make('D', 1), Count: 4},
+			{Tile: tile.Make('H', 2), Count: 4},
+			{Tile: tile.Make('G', 2), Count: 3},
+			{Tile: tile.Make('L', 2), Count: 3},
+			{Tile: tile.Make('O', 2), Count: 3},
+			{Tile: tile.Make('M', 3), Count: 4},
+			{Tile: tile.Make('B', 3), Count: 2},
+			{Tile: tile.Make('W', 3), Count: 1},
+			{Tile: tile.Make('Z', 3), Count: 1},
+			{Tile: tile.Make('C', 4), Count: 2},
+			{Tile: tile.Make('F', 4), Count: 2},
+			{Tile: tile.Make('K', 4), Count: 2},
+			{Tile: tile.Make('P', 4), Count: 1},
+			{Tile: tile.Make('Ä', 6), Count: 1},
+			{Tile: tile.Make('J', 6), Count: 1},
+			{Tile: tile.Make('Ü', 6), Count: 1},
+			{Tile: tile.Make('V', 6), Count: 1},
+			{Tile: tile.Make('Ö', 8), Count: 1},
+			{Tile: tile.Make('X', 8), Count: 1},
+			{Tile: tile.Make('Q', 10), Count: 1},
+			{Tile: tile.Make('Y', 10), Count: 1},
+		},
+		Layout: board.StandardLayout(),
+	}
+}
@@ -0,0 +1,39 @@
+package locale
+
+import (
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// Italian returns the preset for Italian, with 120 tiles (including 2 blanks).
+func Italian() Locale {
+	return Locale{
+		Code: "it",
+		Name: "Italian",
+		Distribution: tile.Distribution{
+			{Tile: tile.MakeBlank(), Count: 2},
+			{Tile: tile.Make('O', 1), Count: 15},
+			{Tile: tile.Make('A', 1), Count: 14},
+			{Tile: tile.Make('I', 1), Count: 12},
+			{Tile: tile.Make('E', 1), Count: 11},
+			{Tile: tile.Make('C', 2), Count: 6},
+			{Tile: tile.Make('R', 2), Count: 6},
+			{Tile: tile.Make('S', 2), Count: 6},
+			{Tile: tile.Make('T', 2), Count: 6},
+			{Tile: tile.Make('L', 3), Count: 5},
+			{Tile: tile.Make('M', 3), Count: 5},
+			{Tile: tile.Make('N', 3), Count: 5},
+			{Tile: tile.Make('U', 3), Count: 5},
+			{Tile: tile.Make('B', 5), Count: 3},
+			{Tile: tile.Make('D', 5), Count: 3},
+			{Tile: tile.Make('F', 5), Count: 3},
+			{Tile: tile.Make('P', 5), Count: 3},
+			{Tile: tile.Make('V', 5), Count: 3},
+			{Tile: tile.Make('G', 8), Count: 2},
+			{Tile: tile.Make('H', 8), Count: 2},
+			{Tile: tile.Make('Z', 8), Count: 2},
+			{Tile: tile.Make('Q', 10), Count: 1},
+		},
+		Layout: board.StandardLayout(),
+	}
+}
@@ -0,0 +1,25 @@
+package locale
+
+import (
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// Locale represents the preset tile distribution and board layout used for
+// playing in a particular language.
+type Locale struct {
+	Code         string
+	Name         string
+	Distribution tile.Distribution
+	Layout       board.Layout
+}
+
+// NewBag returns a new Bag containing this locale's tile distribution.
+func (l Locale) NewBag() tile.Bag {
+	return tile.BagWithDistribution(l.Distribution)
+}
+
+// NewBoard returns a new empty Board with this locale's layout.
+func (l Locale) NewBoard() board.Board {
+	return board.WithLayout(l.Layout)
+}
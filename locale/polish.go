@@ -0,0 +1,50 @@
+package locale
+
+import (
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// Polish returns the preset for Polish, with 100 tiles (including 2 blanks).
+func Polish() Locale {
+	return Locale{
+		Code: "pl",
+		Name: "Polish",
+		Distribution: tile.Distribution{
+			{Tile: tile.MakeBlank(), Count: 2},
+			{Tile: tile.Make('A', 1), Count: 9},
+			{Tile: tile.Make('I', 1), Count: 8},
+			{Tile: tile.Make('E', 1), Count: 7},
+			{Tile: tile.Make('O', 1), Count: 6},
+			{Tile: tile.Make('N', 1), Count: 5},
+			{Tile: tile.Make('Z', 1), Count: 5},
+			{Tile: tile.Make('R', 1), Count: 4},
+			{Tile: tile.Make('S', 1), Count: 4},
+			{Tile: tile.Make('W', 1), Count: 4},
+			{Tile: tile.Make('Y', 2), Count: 4},
+			{Tile: tile.Make('C', 2), Count: 3},
+			{Tile: tile.Make('D', 2), Count: 3},
+			{Tile: tile.Make('K', 2), Count: 3},
+			{Tile: tile.Make('L', 2), Count: 3},
+			{Tile: tile.Make('M', 2), Count: 3},
+			{Tile: tile.Make('P', 2), Count: 3},
+			{Tile: tile.Make('T', 2), Count: 3},
+			{Tile: tile.Make('B', 3), Count: 2},
+			{Tile: tile.Make('G', 3), Count: 2},
+			{Tile: tile.Make('H', 3), Count: 2},
+			{Tile: tile.Make('J', 3), Count: 2},
+			{Tile: tile.Make('Ł', 3), Count: 2},
+			{Tile: tile.Make('U', 3), Count: 2},
+			{Tile: tile.Make('Ą', 5), Count: 1},
+			{Tile: tile.Make('Ę', 5), Count: 1},
+			{Tile: tile.Make('F', 5), Count: 1},
+			{Tile: tile.Make('Ó', 5), Count: 1},
+			{Tile: tile.Make('Ś', 5), Count: 1},
+			{Tile: tile.Make('Ż', 5), Count: 1},
+			{Tile: tile.Make('Ć', 6), Count: 1},
+			{Tile: tile.Make('Ń', 7), Count: 1},
+			{Tile: tile.Make('Ź', 9), Count: 1},
+		},
+		Layout: board.StandardLayout(),
+	}
+}
@@ -0,0 +1,42 @@
+package locale
+
+import (
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// Portuguese returns the preset for Portuguese, with 120 tiles (including 3 blanks).
+func Portuguese() Locale {
+	return Locale{
+		Code: "pt",
+		Name: "Portuguese",
+		Distribution: tile.Distribution{
+			{Tile: tile.MakeBlank(), Count: 3},
+			{Tile: tile.Make('A', 1), Count: 14},
+			{Tile: tile.Make('E', 1), Count: 11},
+			{Tile: tile.Make('I', 1), Count: 10},
+			{Tile: tile.Make('O', 1), Count: 10},
+			{Tile: tile.Make('S', 1), Count: 8},
+			{Tile: tile.Make('U', 1), Count: 7},
+			{Tile: tile.Make('M', 1), Count: 6},
+			{Tile: tile.Make('R', 1), Count: 6},
+			{Tile: tile.Make('T', 1), Count: 5},
+			{Tile: tile.Make('D', 2), Count: 5},
+			{Tile: tile.Make('L', 2), Count: 5},
+			{Tile: tile.Make('C', 2), Count: 4},
+			{Tile: tile.Make('P', 2), Count: 4},
+			{Tile: tile.Make('N', 3), Count: 4},
+			{Tile: tile.Make('B', 3), Count: 3},
+			{Tile: tile.Make('Ç', 3), Count: 2},
+			{Tile: tile.Make('F', 4), Count: 2},
+			{Tile: tile.Make('G', 4), Count: 2},
+			{Tile: tile.Make('H', 4), Count: 2},
+			{Tile: tile.Make('V', 4), Count: 2},
+			{Tile: tile.Make('J', 5), Count: 2},
+			{Tile: tile.Make('Q', 6), Count: 1},
+			{Tile: tile.Make('X', 8), Count: 1},
+			{Tile: tile.Make('Z', 8), Count: 1},
+		},
+		Layout: board.StandardLayout(),
+	}
+}
@@ -0,0 +1,35 @@
+package locale
+
+import "sort"
+
+var registry = map[string]func() Locale{
+	"de":       German,
+	"en":       English,
+	"en-super": EnglishSuper,
+	"es":       Spanish,
+	"fr":       French,
+	"it":       Italian,
+	"nl":       Dutch,
+	"pl":       Polish,
+	"pt":       Portuguese,
+}
+
+// Codes returns the codes of all the preset locales, in sorted order.
+func Codes() []string {
+	codes := make([]string, 0, len(registry))
+	for code := range registry {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Lookup returns the preset locale with the specified code (such as "en" or
+// "fr"). If no such locale exists, false is returned.
+func Lookup(code string) (l Locale, ok bool) {
+	makeLocale, ok := registry[code]
+	if ok {
+		l = makeLocale()
+	}
+	return
+}
@@ -0,0 +1,86 @@
+package locale
+
+import (
+	"testing"
+)
+
+func TestCodes(t *testing.T) {
+
+	t.Run("returns all locale codes in sorted order", func(t *testing.T) {
+		expectedCodes := []string{"de", "en", "en-super", "es", "fr", "it", "nl", "pl", "pt"}
+
+		codes := Codes()
+
+		if actual, expected := len(codes), len(expectedCodes); actual != expected {
+			t.Fatalf("Expected %d locale codes but got %d", expected, actual)
+		}
+		for i, code := range codes {
+			if actual, expected := code, expectedCodes[i]; actual != expected {
+				t.Errorf("Expected locale code '%s' in position %d but got '%s'", expected, i, actual)
+			}
+		}
+	})
+}
+
+func TestLookup(t *testing.T) {
+
+	t.Run("returns the locale for each code", func(t *testing.T) {
+		cases := []struct {
+			Code          string
+			Name          string
+			Tiles, Blanks int
+			Size          int
+		}{
+			{"de", "German", 102, 2, 15},
+			{"en", "English", 100, 2, 15},
+			{"en-super", "English (Super)", 200, 4, 21},
+			{"es", "Spanish", 100, 2, 15},
+			{"fr", "French", 102, 2, 15},
+			{"it", "Italian", 120, 2, 15},
+			{"nl", "Dutch", 102, 2, 15},
+			{"pl", "Polish", 100, 2, 15},
+			{"pt", "Portuguese", 120, 3, 15},
+		}
+
+		for _, c := range cases {
+			l, ok := Lookup(c.Code)
+
+			if !ok {
+				t.Errorf("Expected to find locale '%s'", c.Code)
+				continue
+			}
+			if actual, expected := l.Code, c.Code; actual != expected {
+				t.Errorf("Expected locale code '%s' but got '%s'", expected, actual)
+			}
+			if actual, expected := l.Name, c.Name; actual != expected {
+				t.Errorf("Expected locale name '%s' but got '%s'", expected, actual)
+			}
+
+			bag := l.NewBag()
+			if actual, expected := len(bag), c.Tiles; actual != expected {
+				t.Errorf("Expected %s bag to hold %d tiles but found %d", c.Name, expected, actual)
+			}
+
+			blanks := 0
+			for _, tile := range bag {
+				if tile.Blank {
+					blanks++
+				}
+			}
+			if actual, expected := blanks, c.Blanks; actual != expected {
+				t.Errorf("Expected %s bag to hold %d blanks but found %d", c.Name, expected, actual)
+			}
+
+			b := l.NewBoard()
+			if actual, expected := b.Rows*b.Columns, c.Size*c.Size; actual != expected {
+				t.Errorf("Expected %s board to have %d positions but found %d", c.Name, expected, actual)
+			}
+		}
+	})
+
+	t.Run("returns false for unknown codes", func(t *testing.T) {
+		if _, ok := Lookup("xx"); ok {
+			t.Errorf("Expected not to find a locale for an unknown code")
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package locale
+
+import (
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// Spanish returns the preset for Spanish, with 100 tiles (including 2 blanks).
+// This includes the multi-letter CH, LL, and RR tiles.
+func Spanish() Locale {
+	return Locale{
+		Code: "es",
+		Name: "Spanish",
+		Distribution: tile.Distribution{
+			{Tile: tile.MakeBlank(), Count: 2},
+			{Tile: tile.Make('A', 1), Count: 12},
+			{Tile: tile.Make('E', 1), Count: 12},
+			{Tile: tile.Make('O', 1), Count: 9},
+			{Tile: tile.Make('I', 1), Count: 6},
+			{Tile: tile.Make('S', 1), Count: 6},
+			{Tile: tile.Make('N', 1), Count: 5},
+			{Tile: tile.Make('R', 1), Count: 5},
+			{Tile: tile.Make('U', 1), Count: 5},
+			{Tile: tile.Make('L', 1), Count: 4},
+			{Tile: tile.Make('T', 1), Count: 4},
+			{Tile: tile.Make('D', 2), Count: 5},
+			{Tile: tile.Make('G', 2), Count: 2},
+			{Tile: tile.Make('C', 3), Count: 4},
+			{Tile: tile.Make('B', 3), Count: 2},
+			{Tile: tile.Make('M', 3), Count: 2},
+			{Tile: tile.Make('P', 3), Count: 2},
+			{Tile: tile.Make('H', 4), Count: 2},
+			{Tile: tile.Make('F', 4), Count: 1},
+			{Tile: tile.Make('V', 4), Count: 1},
+			{Tile: tile.Make('Y', 4), Count: 1},
+			{Tile: tile.MakeLetters("CH", 5), Count: 1},
+			{Tile: tile.Make('Q', 5), Count: 1},
+			{Tile: tile.Make('J', 8), Count: 1},
+			{Tile: tile.MakeLetters("LL", 8), Count: 1},
+			{Tile: tile.Make('Ñ', 8), Count: 1},
+			{Tile: tile.MakeLetters("RR", 8), Count: 1},
+			{Tile: tile.Make('X', 8), Count: 1},
+			{Tile: tile.Make('Z', 10), Count: 1},
+		},
+		Layout: board.StandardLayout(),
+	}
+}
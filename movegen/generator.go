@@ -0,0 +1,168 @@
+package movegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/crosscheck"
+	"github.com/mandykoh/scrubble/dict"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/scoring"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// Generator finds the possible plays for a rack of tiles on a board, using the
+// words of a word list.
+//
+// Generators are immutable, and can be shared between goroutines.
+type Generator struct {
+	words              *dict.WordList
+	alphabet           tile.Alphabet
+	root               *node
+	placementValidator play.PlacementValidator
+	wordScorer         scoring.WordScorer
+}
+
+// New returns a Generator which finds plays forming words from the specified
+// word list, using tiles with letters from the given alphabet.
+//
+// Words are split into tile letters using the alphabet (so multiple character
+// letters are matched greedily, as with tile.Alphabet.Tokenise). Words which
+// can't be made from the alphabet's letters are never played.
+func New(words *dict.WordList, alphabet tile.Alphabet) *Generator {
+	gen := &Generator{
+		words:    words,
+		alphabet: alphabet,
+		root:     newNode(),
+	}
+
+	letters := make(map[string]string, len(alphabet))
+	var normalizedTiles []tile.Tile
+	for _, l := range alphabet {
+		n := words.Normalize(l)
+		letters[n] = l
+		normalizedTiles = append(normalizedTiles, tile.MakeLetters(n, 1))
+	}
+	normalizedAlphabet := tile.AlphabetOf(normalizedTiles...)
+
+Words:
+	for _, w := range words.Words() {
+		tokens, err := normalizedAlphabet.Tokenise(w)
+		if err != nil {
+			continue
+		}
+		for i, t := range tokens {
+			l, ok := letters[t]
+			if !ok {
+				continue Words
+			}
+			tokens[i] = l
+		}
+		gen.root.add(tokens)
+	}
+
+	return gen
+}
+
+// Moves returns all of the plays which can be made with the specified rack on
+// the given board, ordered from highest to lowest scoring. The specified table
+// of anchors and cross-checks is used if it isn't nil (so that a table can be
+// kept up to date across turns); otherwise one is computed for the board.
+//
+// Plays are validated using play.ValidatePlacements (unless overridden by
+// WithPlacementValidator), and scored using scoring.ScoreWords (unless
+// overridden by WithWordScorer).
+func (gen *Generator) Moves(b *board.Board, rack tile.Rack, table *crosscheck.Table) []Move {
+	if table == nil {
+		table = gen.Table(b)
+	}
+
+	s := &search{
+		gen:   gen,
+		board: b,
+		table: table,
+		rack:  rack,
+		used:  make([]bool, len(rack)),
+		found: make(map[string]play.Tiles),
+	}
+
+	for _, d := range [...]coord.Direction{coord.AcrossDirection, coord.DownDirection} {
+		s.direction = d
+		for _, anchor := range table.Anchors() {
+			s.fromAnchor(anchor)
+		}
+	}
+
+	validate := gen.placementValidator
+	if validate == nil {
+		validate = play.ValidatePlacements
+	}
+
+	scoreWords := gen.wordScorer
+	if scoreWords == nil {
+		scoreWords = scoring.ScoreWords
+	}
+
+	var moves []Move
+	for _, placements := range s.found {
+		if validate(placements, b) != nil {
+			continue
+		}
+		score, words, err := scoreWords(placements, b, gen.words.Contains)
+		if err != nil {
+			continue
+		}
+
+		_, leave, err := tile.ValidateFromRack(rack, placements.Tiles())
+		if err != nil {
+			continue
+		}
+
+		moves = append(moves, Move{Placements: placements, Score: score, Words: words, Leave: leave})
+	}
+
+	sort.Slice(moves, func(i, j int) bool {
+		if moves[i].Score != moves[j].Score {
+			return moves[i].Score > moves[j].Score
+		}
+		return moveKey(moves[i].Placements) < moveKey(moves[j].Placements)
+	})
+
+	return moves
+}
+
+// Table returns a new table of anchors and cross-checks for the specified
+// board, using this generator's word list and alphabet.
+func (gen *Generator) Table(b *board.Board) *crosscheck.Table {
+	return crosscheck.New(b, gen.alphabet, gen.words.Contains)
+}
+
+// WithPlacementValidator returns a copy of this Generator which uses the
+// specified function to validate the placement of tiles for candidate plays
+// (eg game.Rules.ValidatePlacements, to respect a game's rules).
+func (gen *Generator) WithPlacementValidator(validator play.PlacementValidator) *Generator {
+	copied := *gen
+	copied.placementValidator = validator
+	return &copied
+}
+
+// WithWordScorer returns a copy of this Generator which uses the specified
+// function to score candidate plays, so that moves are ranked according to a
+// game's rules (such as a different bonus schedule). Plays which the scorer
+// rejects are left out.
+func (gen *Generator) WithWordScorer(scorer scoring.WordScorer) *Generator {
+	copied := *gen
+	copied.wordScorer = scorer
+	return &copied
+}
+
+func moveKey(placements play.Tiles) string {
+	var key strings.Builder
+	for _, p := range placements {
+		fmt.Fprintf(&key, "%v%v", p.Tile, p.Coord)
+	}
+	return key.String()
+}
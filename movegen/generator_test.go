@@ -0,0 +1,178 @@
+package movegen
+
+import (
+	"testing"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/dict"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func TestGenerator(t *testing.T) {
+	__, st, dl, _, _, _ := board.AllPositionTypes()
+
+	words := dict.NewWordList([]string{"at", "cat", "act", "ta", "tac", "cats", "scat"}, dict.FoldCase("en"))
+	alphabet := tile.Alphabet{"A", "C", "S", "T"}
+
+	setupBoard := func() board.Board {
+		return board.WithLayout(board.Layout{
+			{__, __, __, __, __},
+			{__, __, __, __, __},
+			{__, dl, st, __, __},
+			{__, __, __, __, __},
+			{__, __, __, __, __},
+		})
+	}
+
+	expectMove := func(t *testing.T, moves []Move, score int, placements play.Tiles) {
+		t.Helper()
+
+		for _, m := range moves {
+			if moveKey(m.Placements) == moveKey(placements) {
+				if m.Score != score {
+					t.Errorf("Expected move %v to score %d but got %d", placements, score, m.Score)
+				}
+				return
+			}
+		}
+		t.Errorf("Expected move %v to be found", placements)
+	}
+
+	t.Run(".Moves()", func(t *testing.T) {
+
+		t.Run("finds plays through the start position on an empty board", func(t *testing.T) {
+			b := setupBoard()
+			gen := New(words, alphabet)
+
+			moves := gen.Moves(&b, tile.Rack{tile.Make('C', 3), tile.Make('A', 1), tile.Make('T', 1)}, nil)
+
+			expectMove(t, moves, 16, play.Tiles{
+				{tile.Make('C', 3), coord.Make(2, 1)},
+				{tile.Make('A', 1), coord.Make(2, 2)},
+				{tile.Make('T', 1), coord.Make(2, 3)},
+			})
+			expectMove(t, moves, 10, play.Tiles{
+				{tile.Make('C', 3), coord.Make(0, 2)},
+				{tile.Make('A', 1), coord.Make(1, 2)},
+				{tile.Make('T', 1), coord.Make(2, 2)},
+			})
+
+			for _, m := range moves {
+				if len(m.Placements) == 1 {
+					t.Errorf("Expected no single tile words but found %v", m.Placements)
+				}
+			}
+		})
+
+		t.Run("orders moves from highest to lowest scoring", func(t *testing.T) {
+			b := setupBoard()
+			gen := New(words, alphabet)
+
+			moves := gen.Moves(&b, tile.Rack{tile.Make('C', 3), tile.Make('A', 1), tile.Make('T', 1)}, nil)
+
+			for i := 1; i < len(moves); i++ {
+				if moves[i].Score > moves[i-1].Score {
+					t.Errorf("Expected move %d (%d points) not to outscore move %d (%d points)", i, moves[i].Score, i-1, moves[i-1].Score)
+				}
+			}
+		})
+
+		t.Run("extends and hooks onto existing tiles", func(t *testing.T) {
+			b := setupBoard()
+			b.Position(coord.Make(2, 1)).Tile = &tile.Tile{Letter: "C", Points: 3}
+			b.Position(coord.Make(2, 2)).Tile = &tile.Tile{Letter: "A", Points: 1}
+			b.Position(coord.Make(2, 3)).Tile = &tile.Tile{Letter: "T", Points: 1}
+			gen := New(words, alphabet)
+
+			moves := gen.Moves(&b, tile.Rack{tile.Make('S', 1)}, nil)
+
+			expectMove(t, moves, 6, play.Tiles{{tile.Make('S', 1), coord.Make(2, 4)}})
+			expectMove(t, moves, 6, play.Tiles{{tile.Make('S', 1), coord.Make(2, 0)}})
+		})
+
+		t.Run("uses blanks in place of letters on the rack", func(t *testing.T) {
+			b := setupBoard()
+			gen := New(words, alphabet)
+
+			moves := gen.Moves(&b, tile.Rack{tile.MakeBlank(), tile.Make('A', 1), tile.Make('T', 1)}, nil)
+
+			expectMove(t, moves, 4, play.Tiles{
+				{tile.Make('A', 1), coord.Make(2, 1)},
+				{tile.MakeBlank().Designate("T"), coord.Make(2, 2)},
+			})
+			expectMove(t, moves, 2, play.Tiles{
+				{tile.MakeBlank().Designate("A"), coord.Make(2, 1)},
+				{tile.Make('T', 1), coord.Make(2, 2)},
+			})
+		})
+
+		t.Run("uses blanks for letters not on the rack", func(t *testing.T) {
+			b := setupBoard()
+			gen := New(words, alphabet)
+
+			moves := gen.Moves(&b, tile.Rack{tile.MakeBlank(), tile.Make('T', 1)}, nil)
+
+			expectMove(t, moves, 2, play.Tiles{
+				{tile.MakeBlank().Designate("A"), coord.Make(2, 2)},
+				{tile.Make('T', 1), coord.Make(2, 3)},
+			})
+		})
+
+		t.Run("includes the leave of each move", func(t *testing.T) {
+			b := setupBoard()
+			gen := New(words, alphabet)
+
+			moves := gen.Moves(&b, tile.Rack{tile.Make('A', 1), tile.Make('T', 1), tile.Make('S', 1)}, nil)
+
+			for _, m := range moves {
+				if actual, expected := len(m.Leave), 3-len(m.Placements); actual != expected {
+					t.Errorf("Expected leave of %d tiles for %v but got %v", expected, m.Placements, m.Leave)
+				}
+			}
+		})
+
+		t.Run("uses the specified placement validator", func(t *testing.T) {
+			b := setupBoard()
+			gen := New(words, alphabet).WithPlacementValidator(func(placements play.Tiles, b *board.Board) error {
+				if len(placements) < 3 {
+					return play.InvalidTilePlacementError{Reason: play.FirstPlayTooFewTilesReason}
+				}
+				return nil
+			})
+
+			moves := gen.Moves(&b, tile.Rack{tile.Make('C', 3), tile.Make('A', 1), tile.Make('T', 1)}, nil)
+
+			if len(moves) == 0 {
+				t.Fatalf("Expected some moves to be found")
+			}
+			for _, m := range moves {
+				if len(m.Placements) < 3 {
+					t.Errorf("Expected only three tile plays but found %v", m.Placements)
+				}
+			}
+		})
+
+		t.Run("uses the specified word scorer", func(t *testing.T) {
+			b := setupBoard()
+			gen := New(words, alphabet).WithWordScorer(func(placements play.Tiles, b *board.Board, isWordValid dict.Dictionary) (int, []play.Word, error) {
+				if len(placements) < 3 {
+					return 0, nil, play.InvalidTilePlacementError{Reason: play.FirstPlayTooFewTilesReason}
+				}
+				return 100 * len(placements), nil, nil
+			})
+
+			moves := gen.Moves(&b, tile.Rack{tile.Make('C', 3), tile.Make('A', 1), tile.Make('T', 1)}, nil)
+
+			if len(moves) == 0 {
+				t.Fatalf("Expected some moves to be found")
+			}
+			for _, m := range moves {
+				if actual, expected := m.Score, 300; actual != expected {
+					t.Errorf("Expected only three tile plays scoring %d but found %v scoring %d", expected, m.Placements, actual)
+				}
+			}
+		})
+	})
+}
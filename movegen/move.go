@@ -0,0 +1,22 @@
+package movegen
+
+import (
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// Move represents a candidate play found by a Generator.
+type Move struct {
+
+	// Placements are the tiles to be placed for the play.
+	Placements play.Tiles
+
+	// Score is the score the play would receive.
+	Score int
+
+	// Words are the words the play would form.
+	Words []play.Word
+
+	// Leave is the tiles which would remain on the rack after the play.
+	Leave []tile.Tile
+}
@@ -0,0 +1,160 @@
+package movegen
+
+import (
+	"sort"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/crosscheck"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// search holds the state of a search for moves, following the approach of
+// Appel and Jacobson: for each anchor, every possible left part of a word is
+// built from the rack (or taken from the tiles already on the board), and
+// then extended rightwards through the anchor.
+type search struct {
+	gen       *Generator
+	board     *board.Board
+	table     *crosscheck.Table
+	rack      tile.Rack
+	used      []bool
+	direction coord.Direction
+	found     map[string]play.Tiles
+}
+
+func (s *search) extendRight(n *node, c coord.Coord, anchor coord.Coord, placed play.Tiles) {
+	pos := s.board.Position(c)
+	pastAnchor := c != anchor
+
+	if pos == nil || pos.IsBlocked() {
+		if n.terminal && pastAnchor {
+			s.record(placed)
+		}
+		return
+	}
+
+	if pos.Tile != nil {
+		if child := n.children[pos.Tile.Letter]; child != nil {
+			s.extendRight(child, s.direction.Next(c), anchor, placed)
+		}
+		return
+	}
+
+	if n.terminal && pastAnchor {
+		s.record(placed)
+	}
+
+	located, _ := s.board.Locate(c)
+	check := s.table.Check(located, s.direction)
+
+	for letter, child := range n.children {
+		if !check.Allows(letter) {
+			continue
+		}
+		for _, blank := range [...]bool{false, true} {
+			if i, t, ok := s.take(letter, blank); ok {
+				s.extendRight(child, s.direction.Next(c), anchor, append(placed, play.TilePlacement{Tile: t, Coord: located}))
+				s.used[i] = false
+			}
+		}
+	}
+}
+
+func (s *search) fromAnchor(anchor coord.Coord) {
+	prev := s.direction.Previous(anchor)
+
+	// Where tiles are already before the anchor, they form the only left part
+	if pos := s.board.Position(prev); pos != nil && pos.Tile != nil {
+		var prefix []string
+		for ; pos != nil && pos.Tile != nil && len(prefix) < s.lineLength(); pos = s.board.Position(prev) {
+			prefix = append([]string{pos.Tile.Letter}, prefix...)
+			prev = s.direction.Previous(prev)
+		}
+
+		n := s.gen.root
+		for _, l := range prefix {
+			if n = n.children[l]; n == nil {
+				return
+			}
+		}
+		s.extendRight(n, anchor, anchor, nil)
+		return
+	}
+
+	limit := 0
+	for c := prev; limit < len(s.rack)-1; c = s.direction.Previous(c) {
+		pos := s.board.Position(c)
+		if pos == nil || pos.Tile != nil || pos.IsBlocked() || s.table.IsAnchor(c) {
+			break
+		}
+		limit++
+	}
+
+	s.leftPart(s.gen.root, anchor, nil, limit)
+}
+
+func (s *search) leftPart(n *node, anchor coord.Coord, left []tile.Tile, limit int) {
+	var placed play.Tiles
+	c := anchor
+	for i := len(left) - 1; i >= 0; i-- {
+		c = s.direction.Previous(c)
+		located, _ := s.board.Locate(c)
+		placed = append(play.Tiles{{Tile: left[i], Coord: located}}, placed...)
+	}
+	s.extendRight(n, anchor, anchor, placed)
+
+	if limit <= 0 {
+		return
+	}
+
+	for letter, child := range n.children {
+		for _, blank := range [...]bool{false, true} {
+			if i, t, ok := s.take(letter, blank); ok {
+				s.leftPart(child, anchor, append(left, t), limit-1)
+				s.used[i] = false
+			}
+		}
+	}
+}
+
+func (s *search) lineLength() int {
+	if s.direction == coord.DownDirection {
+		return s.board.Rows
+	}
+	return s.board.Columns
+}
+
+func (s *search) record(placed play.Tiles) {
+	placements := append(play.Tiles{}, placed...)
+	sort.Slice(placements, func(i, j int) bool {
+		a, b := placements[i].Coord, placements[j].Coord
+		if a.Row != b.Row {
+			return a.Row < b.Row
+		}
+		return a.Column < b.Column
+	})
+	s.found[moveKey(placements)] = placements
+}
+
+// take marks a tile from the rack with the specified letter as used, returning
+// its index and the tile to be placed. If blank is true, a blank is used
+// (designated with the letter) instead of a tile with the letter, so that
+// searches can try both.
+func (s *search) take(letter string, blank bool) (index int, t tile.Tile, ok bool) {
+	for i, rt := range s.rack {
+		if s.used[i] || rt.Blank != blank {
+			continue
+		}
+		if blank {
+			s.used[i] = true
+			return i, rt.Designate(letter), true
+		}
+		if rt.Letter == letter {
+			s.used[i] = true
+			return i, rt, true
+		}
+	}
+	return -1, tile.Tile{}, false
+}
@@ -0,0 +1,66 @@
+package movegen
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// BlankValue is the number of points a blank is considered to be worth by the
+// SaveBlanks strategy, such that a blank is only used when it gains at least
+// this many points.
+const BlankValue = 25
+
+// Strategy represents a function which orders candidate moves by preference,
+// for choosing which play a computer player should make. The supplied random
+// number generator is used for any random choices.
+//
+// The returned moves may be a subset of those given. The moves passed in are
+// not modified.
+type Strategy func(moves []Move, r *rand.Rand) (preferred []Move)
+
+// HighestScore implements a Strategy which prefers the highest scoring moves.
+func HighestScore(moves []Move, r *rand.Rand) []Move {
+	return sortedBy(moves, func(m Move) int { return m.Score })
+}
+
+// MostTiles implements a Strategy which prefers the moves which play the most
+// tiles, and then the highest scoring of those.
+func MostTiles(moves []Move, r *rand.Rand) []Move {
+	preferred := sortedBy(moves, func(m Move) int { return m.Score })
+	sort.SliceStable(preferred, func(i, j int) bool {
+		return len(preferred[i].Placements) > len(preferred[j].Placements)
+	})
+	return preferred
+}
+
+// RandomMove implements a Strategy which prefers moves in random order.
+func RandomMove(moves []Move, r *rand.Rand) []Move {
+	preferred := append([]Move{}, moves...)
+	r.Shuffle(len(preferred), func(i, j int) {
+		preferred[i], preferred[j] = preferred[j], preferred[i]
+	})
+	return preferred
+}
+
+// SaveBlanks implements a Strategy which prefers the highest scoring moves, but
+// values each blank played at BlankValue points so that blanks are saved for
+// plays which make good use of them.
+func SaveBlanks(moves []Move, r *rand.Rand) []Move {
+	return sortedBy(moves, func(m Move) int {
+		value := m.Score
+		for _, p := range m.Placements {
+			if p.Tile.Blank {
+				value -= BlankValue
+			}
+		}
+		return value
+	})
+}
+
+func sortedBy(moves []Move, value func(Move) int) []Move {
+	preferred := append([]Move{}, moves...)
+	sort.SliceStable(preferred, func(i, j int) bool {
+		return value(preferred[i]) > value(preferred[j])
+	})
+	return preferred
+}
@@ -0,0 +1,72 @@
+package movegen
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func TestStrategies(t *testing.T) {
+	short := Move{Score: 20, Placements: play.Tiles{
+		{tile.Make('Q', 10), coord.Make(0, 0)},
+	}}
+	long := Move{Score: 10, Placements: play.Tiles{
+		{tile.Make('A', 1), coord.Make(0, 0)},
+		{tile.Make('T', 1), coord.Make(0, 1)},
+	}}
+	blank := Move{Score: 30, Placements: play.Tiles{
+		{tile.MakeBlank().Designate("Z"), coord.Make(0, 0)},
+	}}
+	moves := []Move{long, short, blank}
+
+	expectOrder := func(t *testing.T, preferred []Move, expected ...Move) {
+		t.Helper()
+
+		if actual, expectedLen := len(preferred), len(expected); actual != expectedLen {
+			t.Fatalf("Expected %d moves but got %d", expectedLen, actual)
+		}
+		for i, e := range expected {
+			if moveKey(preferred[i].Placements) != moveKey(e.Placements) {
+				t.Errorf("Expected move %v in position %d but found %v", e.Placements, i, preferred[i].Placements)
+			}
+		}
+	}
+
+	t.Run("HighestScore()", func(t *testing.T) {
+
+		t.Run("prefers the highest scoring moves", func(t *testing.T) {
+			expectOrder(t, HighestScore(moves, nil), blank, short, long)
+		})
+
+		t.Run("doesn't modify the given moves", func(t *testing.T) {
+			HighestScore(moves, nil)
+			expectOrder(t, moves, long, short, blank)
+		})
+	})
+
+	t.Run("MostTiles()", func(t *testing.T) {
+
+		t.Run("prefers moves playing the most tiles", func(t *testing.T) {
+			expectOrder(t, MostTiles(moves, nil), long, blank, short)
+		})
+	})
+
+	t.Run("RandomMove()", func(t *testing.T) {
+
+		t.Run("returns all moves", func(t *testing.T) {
+			if actual, expected := len(RandomMove(moves, rand.New(rand.NewSource(1)))), len(moves); actual != expected {
+				t.Errorf("Expected %d moves but got %d", expected, actual)
+			}
+		})
+	})
+
+	t.Run("SaveBlanks()", func(t *testing.T) {
+
+		t.Run("discounts moves playing blanks", func(t *testing.T) {
+			expectOrder(t, SaveBlanks(moves, nil), short, long, blank)
+		})
+	})
+}
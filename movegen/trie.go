@@ -0,0 +1,24 @@
+package movegen
+
+// node represents a node in a trie of words, where each edge is the letter of
+// a tile.
+type node struct {
+	children map[string]*node
+	terminal bool
+}
+
+func newNode() *node {
+	return &node{children: make(map[string]*node)}
+}
+
+func (n *node) add(letters []string) {
+	for _, l := range letters {
+		child := n.children[l]
+		if child == nil {
+			child = newNode()
+			n.children[l] = child
+		}
+		n = child
+	}
+	n.terminal = true
+}
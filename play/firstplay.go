@@ -0,0 +1,66 @@
+package play
+
+import (
+	"github.com/mandykoh/scrubble/board"
+)
+
+// FirstPlayAll returns a FirstPlayValidator which requires the first play to
+// satisfy all of the specified validators. Validators are checked in order, and
+// the first violation found is returned.
+func FirstPlayAll(validators ...FirstPlayValidator) FirstPlayValidator {
+	return func(placements Tiles, b *board.Board) error {
+		for _, v := range validators {
+			if err := v(placements, b); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// FirstPlayAnywhere implements a FirstPlayValidator which allows the first play
+// to be made anywhere on the board (a "free start").
+func FirstPlayAnywhere(placements Tiles, b *board.Board) error {
+	return nil
+}
+
+// FirstPlayMinTiles returns a FirstPlayValidator which requires the first play
+// to place at least the specified number of tiles.
+//
+// If fewer tiles are placed, InvalidTilePlacementError is returned with a
+// reason of FirstPlayTooFewTilesReason.
+func FirstPlayMinTiles(count int) FirstPlayValidator {
+	return func(placements Tiles, b *board.Board) error {
+		if len(placements) < count {
+			return InvalidTilePlacementError{FirstPlayTooFewTilesReason}
+		}
+		return nil
+	}
+}
+
+// FirstPlayNotSingleTile implements a FirstPlayValidator which requires the
+// first play to place more than one tile.
+//
+// If only one tile is placed, InvalidTilePlacementError is returned with a
+// reason of FirstPlaySingleTileReason.
+func FirstPlayNotSingleTile(placements Tiles, b *board.Board) error {
+	if len(placements) == 1 {
+		return InvalidTilePlacementError{FirstPlaySingleTileReason}
+	}
+	return nil
+}
+
+// FirstPlayOnStart implements a FirstPlayValidator which requires the first
+// play to cover a starting position (any position whose type counts as
+// connected).
+//
+// If no tiles cover a starting position, InvalidTilePlacementError is returned
+// with a reason of FirstPlayNotOnStartReason.
+func FirstPlayOnStart(placements Tiles, b *board.Board) error {
+	for _, p := range placements {
+		if pos := b.Position(p.Coord); pos != nil && pos.Type.CountsAsConnected() {
+			return nil
+		}
+	}
+	return InvalidTilePlacementError{FirstPlayNotOnStartReason}
+}
@@ -0,0 +1,130 @@
+package play
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func TestFirstPlayAll(t *testing.T) {
+	b := board.WithStandardLayout()
+
+	t.Run("succeeds when all validators succeed", func(t *testing.T) {
+		validator := FirstPlayAll(FirstPlayAnywhere, FirstPlayAnywhere)
+
+		if err := validator(Tiles{{tile.Make('A', 1), coord.Make(0, 0)}}, &b); err != nil {
+			t.Errorf("Expected success but got error %v", err)
+		}
+	})
+
+	t.Run("returns the first violation found", func(t *testing.T) {
+		firstErr := errors.New("first")
+		secondErr := errors.New("second")
+
+		validator := FirstPlayAll(
+			FirstPlayAnywhere,
+			func(Tiles, *board.Board) error { return firstErr },
+			func(Tiles, *board.Board) error { return secondErr },
+		)
+
+		if actual, expected := validator(Tiles{}, &b), firstErr; actual != expected {
+			t.Errorf("Expected error %v but got %v", expected, actual)
+		}
+	})
+}
+
+func TestFirstPlayAnywhere(t *testing.T) {
+	b := board.WithStandardLayout()
+
+	t.Run("allows tiles anywhere", func(t *testing.T) {
+		err := FirstPlayAnywhere(Tiles{
+			{tile.Make('A', 1), coord.Make(0, 0)},
+		}, &b)
+
+		if err != nil {
+			t.Errorf("Expected success but got error %v", err)
+		}
+	})
+}
+
+func TestFirstPlayMinTiles(t *testing.T) {
+	b := board.WithStandardLayout()
+	validator := FirstPlayMinTiles(3)
+
+	t.Run("returns an error when too few tiles are placed", func(t *testing.T) {
+		err := validator(Tiles{
+			{tile.Make('A', 1), coord.Make(7, 7)},
+			{tile.Make('B', 1), coord.Make(7, 8)},
+		}, &b)
+
+		if actual, expected := err, (InvalidTilePlacementError{Reason: FirstPlayTooFewTilesReason}); actual != expected {
+			t.Errorf("Expected %v but got %v", expected, actual)
+		}
+	})
+
+	t.Run("succeeds when enough tiles are placed", func(t *testing.T) {
+		err := validator(Tiles{
+			{tile.Make('A', 1), coord.Make(7, 7)},
+			{tile.Make('B', 1), coord.Make(7, 8)},
+			{tile.Make('C', 1), coord.Make(7, 9)},
+		}, &b)
+
+		if err != nil {
+			t.Errorf("Expected success but got error %v", err)
+		}
+	})
+}
+
+func TestFirstPlayNotSingleTile(t *testing.T) {
+	b := board.WithStandardLayout()
+
+	t.Run("returns an error when a single tile is placed", func(t *testing.T) {
+		err := FirstPlayNotSingleTile(Tiles{
+			{tile.Make('A', 1), coord.Make(7, 7)},
+		}, &b)
+
+		if actual, expected := err, (InvalidTilePlacementError{Reason: FirstPlaySingleTileReason}); actual != expected {
+			t.Errorf("Expected %v but got %v", expected, actual)
+		}
+	})
+
+	t.Run("succeeds when multiple tiles are placed", func(t *testing.T) {
+		err := FirstPlayNotSingleTile(Tiles{
+			{tile.Make('A', 1), coord.Make(7, 7)},
+			{tile.Make('B', 1), coord.Make(7, 8)},
+		}, &b)
+
+		if err != nil {
+			t.Errorf("Expected success but got error %v", err)
+		}
+	})
+}
+
+func TestFirstPlayOnStart(t *testing.T) {
+	b := board.WithStandardLayout()
+
+	t.Run("returns an error when no tile covers a starting position", func(t *testing.T) {
+		err := FirstPlayOnStart(Tiles{
+			{tile.Make('A', 1), coord.Make(7, 8)},
+			{tile.Make('B', 1), coord.Make(7, 9)},
+		}, &b)
+
+		if actual, expected := err, (InvalidTilePlacementError{Reason: FirstPlayNotOnStartReason}); actual != expected {
+			t.Errorf("Expected %v but got %v", expected, actual)
+		}
+	})
+
+	t.Run("succeeds when a tile covers a starting position", func(t *testing.T) {
+		err := FirstPlayOnStart(Tiles{
+			{tile.Make('A', 1), coord.Make(7, 7)},
+			{tile.Make('B', 1), coord.Make(7, 8)},
+		}, &b)
+
+		if err != nil {
+			t.Errorf("Expected success but got error %v", err)
+		}
+	})
+}
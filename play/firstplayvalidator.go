@@ -0,0 +1,11 @@
+package play
+
+import (
+	"github.com/mandykoh/scrubble/board"
+)
+
+// FirstPlayValidator represents a function which validates the placement of
+// tiles for the first play of a game, onto an empty board. This takes the place
+// of the usual requirement that tiles be placed connected to a starting
+// position or to existing tiles.
+type FirstPlayValidator func(placements Tiles, board *board.Board) error
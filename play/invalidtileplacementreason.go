@@ -31,6 +31,18 @@ const (
 	// down such that they were not on a starting position and not touching any
 	// existing tiles.
 	PlacementNotConnectedReason
+
+	// FirstPlayNotOnStartReason indicates that the first play of a game did
+	// not cover a starting position.
+	FirstPlayNotOnStartReason
+
+	// FirstPlayTooFewTilesReason indicates that the first play of a game did
+	// not place the minimum required number of tiles.
+	FirstPlayTooFewTilesReason
+
+	// FirstPlaySingleTileReason indicates that the first play of a game
+	// attempted to place only a single tile.
+	FirstPlaySingleTileReason
 )
 
 // InvalidTilePlacementReason indicates the reason for an
@@ -55,6 +67,12 @@ func (r InvalidTilePlacementReason) GoString() string {
 		return "PlacementNotContiguousReason"
 	case PlacementNotConnectedReason:
 		return "PlacementNotConnectedReason"
+	case FirstPlayNotOnStartReason:
+		return "FirstPlayNotOnStartReason"
+	case FirstPlayTooFewTilesReason:
+		return "FirstPlayTooFewTilesReason"
+	case FirstPlaySingleTileReason:
+		return "FirstPlaySingleTileReason"
 	default:
 		return "UnknownInvalidTilePlacementReason"
 	}
@@ -78,6 +96,12 @@ func (r InvalidTilePlacementReason) String() string {
 		return "PlacementNotContiguous"
 	case PlacementNotConnectedReason:
 		return "PlacementNotConnected"
+	case FirstPlayNotOnStartReason:
+		return "FirstPlayNotOnStart"
+	case FirstPlayTooFewTilesReason:
+		return "FirstPlayTooFewTiles"
+	case FirstPlaySingleTileReason:
+		return "FirstPlaySingleTile"
 	default:
 		return "Unknown"
 	}
@@ -18,6 +18,9 @@ func TestInvalidTilePlacementReason(t *testing.T) {
 				{PlacementNotLinearReason, "PlacementNotLinearReason"},
 				{PlacementNotContiguousReason, "PlacementNotContiguousReason"},
 				{PlacementNotConnectedReason, "PlacementNotConnectedReason"},
+				{FirstPlayNotOnStartReason, "FirstPlayNotOnStartReason"},
+				{FirstPlayTooFewTilesReason, "FirstPlayTooFewTilesReason"},
+				{FirstPlaySingleTileReason, "FirstPlaySingleTileReason"},
 				{UnknownInvalidTilePlacementReason, "UnknownInvalidTilePlacementReason"},
 			}
 
@@ -53,6 +56,9 @@ func TestInvalidTilePlacementReason(t *testing.T) {
 				{PlacementNotLinearReason, "PlacementNotLinear"},
 				{PlacementNotContiguousReason, "PlacementNotContiguous"},
 				{PlacementNotConnectedReason, "PlacementNotConnected"},
+				{FirstPlayNotOnStartReason, "FirstPlayNotOnStart"},
+				{FirstPlayTooFewTilesReason, "FirstPlayTooFewTiles"},
+				{FirstPlaySingleTileReason, "FirstPlaySingleTile"},
 			}
 
 			for _, c := range cases {
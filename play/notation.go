@@ -0,0 +1,90 @@
+package play
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/coord"
+)
+
+// Notation returns the conventional notation for a play of the specified
+// placements which forms the given words, on the board as it was before the
+// play. Plays across are written with the row number first and plays down with
+// the column letter first, followed by the main word (the one containing all
+// of the placed tiles). Tiles already on the board are shown in brackets and
+// blank tiles in lower case, eg "8D wOR(D)" or "H4 (TR)AIN".
+//
+// An empty string is returned if none of the words contain all of the placed
+// tiles.
+func Notation(placements Tiles, words []Word, b *board.Board) string {
+	main := mainWord(placements, words, b)
+	if main == nil {
+		return ""
+	}
+
+	var n strings.Builder
+
+	start, _ := b.Locate(main.Min)
+	if main.Min.Row == main.Max.Row {
+		fmt.Fprintf(&n, "%d%s ", start.Row+1, columnLabel(start.Column))
+	} else {
+		fmt.Fprintf(&n, "%s%d ", columnLabel(start.Column), start.Row+1)
+	}
+
+	existing := false
+	main.Each(func(c coord.Coord) error {
+		located, _ := b.Locate(c)
+
+		if p := placements.Find(located); p != nil {
+			if existing {
+				n.WriteString(")")
+				existing = false
+			}
+			if p.Tile.Blank {
+				n.WriteString(strings.ToLower(p.Tile.Letter))
+			} else {
+				n.WriteString(p.Tile.Letter)
+			}
+
+		} else if pos := b.Position(located); pos != nil && pos.Tile != nil {
+			if !existing {
+				n.WriteString("(")
+				existing = true
+			}
+			n.WriteString(pos.Tile.Letter)
+		}
+		return nil
+	})
+	if existing {
+		n.WriteString(")")
+	}
+
+	return n.String()
+}
+
+func columnLabel(column int) string {
+	label := string(rune('A' + column%26))
+	for column >= 26 {
+		column = column/26 - 1
+		label = string(rune('A'+column%26)) + label
+	}
+	return label
+}
+
+func mainWord(placements Tiles, words []Word, b *board.Board) *Word {
+	for i := range words {
+		covered := 0
+		words[i].Each(func(c coord.Coord) error {
+			located, _ := b.Locate(c)
+			if placements.Find(located) != nil {
+				covered++
+			}
+			return nil
+		})
+		if covered == len(placements) {
+			return &words[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package play
+
+import (
+	"testing"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func TestNotation(t *testing.T) {
+
+	t.Run("writes plays across with the row first", func(t *testing.T) {
+		b := board.WithStandardLayout()
+		placements := Tiles{
+			{tile.Make('W', 4), coord.Make(7, 3)},
+			{tile.Make('O', 1), coord.Make(7, 4)},
+			{tile.Make('R', 1), coord.Make(7, 5)},
+			{tile.Make('D', 2), coord.Make(7, 6)},
+		}
+		words := []Word{{Word: "WORD", Score: 8, Range: coord.Range{Min: coord.Make(7, 3), Max: coord.Make(7, 6)}}}
+
+		if actual, expected := Notation(placements, words, &b), "8D WORD"; actual != expected {
+			t.Errorf("Expected notation %q but got %q", expected, actual)
+		}
+	})
+
+	t.Run("writes plays down with the column first and existing tiles in brackets", func(t *testing.T) {
+		b := board.WithStandardLayout()
+		existing := Tiles{
+			{tile.Make('T', 1), coord.Make(3, 7)},
+			{tile.Make('R', 1), coord.Make(4, 7)},
+			{tile.Make('N', 1), coord.Make(7, 7)},
+		}
+		existing.Place(&b)
+
+		placements := Tiles{
+			{tile.Make('A', 1), coord.Make(5, 7)},
+			{tile.MakeBlank().Designate("I"), coord.Make(6, 7)},
+			{tile.Make('S', 1), coord.Make(8, 7)},
+		}
+		words := []Word{
+			{Word: "AX", Score: 9, Range: coord.Range{Min: coord.Make(5, 7), Max: coord.Make(5, 8)}},
+			{Word: "TRAINS", Score: 5, Range: coord.Range{Min: coord.Make(3, 7), Max: coord.Make(8, 7)}},
+		}
+
+		if actual, expected := Notation(placements, words, &b), "H4 (TR)Ai(N)S"; actual != expected {
+			t.Errorf("Expected notation %q but got %q", expected, actual)
+		}
+	})
+
+	t.Run("returns an empty string when no word contains all placements", func(t *testing.T) {
+		b := board.WithStandardLayout()
+		placements := Tiles{
+			{tile.Make('A', 1), coord.Make(7, 7)},
+			{tile.Make('B', 1), coord.Make(9, 7)},
+		}
+		words := []Word{{Word: "AB", Score: 2, Range: coord.Range{Min: coord.Make(7, 7), Max: coord.Make(7, 8)}}}
+
+		if actual, expected := Notation(placements, words, &b), ""; actual != expected {
+			t.Errorf("Expected notation %q but got %q", expected, actual)
+		}
+	})
+}
@@ -0,0 +1,18 @@
+package play
+
+import "github.com/mandykoh/scrubble/coord"
+
+// PlacementViolation describes a single problem with the placement of tiles,
+// as found by FindPlacementViolations.
+type PlacementViolation struct {
+
+	// Reason indicates the kind of violation.
+	Reason InvalidTilePlacementReason
+
+	// Coords are the coordinates involved in the violation, such as the tiles
+	// placed on occupied or out of bounds positions, or the empty positions
+	// which leave gaps between placed tiles. Violations concerning the play as
+	// a whole (such as it not being connected) include the coordinates of all
+	// of the placed tiles.
+	Coords []coord.Coord
+}
@@ -0,0 +1,14 @@
+package play
+
+import "fmt"
+
+// PlacementViolationsError indicates that a play called for placing tiles in
+// an invalid manner, and describes every violation found (see
+// ValidateAllPlacements).
+type PlacementViolationsError struct {
+	Violations []PlacementViolation
+}
+
+func (e PlacementViolationsError) Error() string {
+	return fmt.Sprintf("%#v", e)
+}
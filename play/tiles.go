@@ -25,6 +25,15 @@ func (tp Tiles) Bounds() coord.Range {
 	return bounds
 }
 
+// Coords returns the coordinates of the placements.
+func (tp Tiles) Coords() []coord.Coord {
+	coords := make([]coord.Coord, len(tp))
+	for i, p := range tp {
+		coords[i] = p.Coord
+	}
+	return coords
+}
+
 // Find returns the first placement corresponding to the given coordinate, or
 // nil if no matching placement exists.
 func (tp Tiles) Find(c coord.Coord) *TilePlacement {
@@ -115,8 +115,8 @@ func TestTilePlacements(t *testing.T) {
 			if p == nil {
 				t.Errorf("Expected a valid placement but was nil")
 			} else {
-				if actual, expected := p.Tile.Letter, 'C'; actual != expected {
-					t.Errorf("Expected to get placement for tile %c first but got %c", expected, actual)
+				if actual, expected := p.Tile.Letter, "C"; actual != expected {
+					t.Errorf("Expected to get placement for tile %s first but got %s", expected, actual)
 				}
 				if actual, expected := len(placements), 3; actual != expected {
 					t.Errorf("Expected %d placements to remain but found %d", expected, actual)
@@ -128,8 +128,8 @@ func TestTilePlacements(t *testing.T) {
 			if p == nil {
 				t.Errorf("Expected a valid placement but was nil")
 			} else {
-				if actual, expected := p.Tile.Letter, 'D'; actual != expected {
-					t.Errorf("Expected to get placement for tile %c first but got %c", expected, actual)
+				if actual, expected := p.Tile.Letter, "D"; actual != expected {
+					t.Errorf("Expected to get placement for tile %s first but got %s", expected, actual)
 				}
 				if actual, expected := len(placements), 2; actual != expected {
 					t.Errorf("Expected %d placements to remain but found %d", expected, actual)
@@ -166,8 +166,8 @@ func TestTilePlacements(t *testing.T) {
 			if p == nil {
 				t.Errorf("Expected a valid placement but got nil")
 			} else {
-				if actual, expected := p.Tile.Letter, 'D'; actual != expected {
-					t.Errorf("Expected to get placement for tile %c first but got %c", expected, actual)
+				if actual, expected := p.Tile.Letter, "D"; actual != expected {
+					t.Errorf("Expected to get placement for tile %s first but got %s", expected, actual)
 				}
 				if actual, expected := len(placements), 3; actual != expected {
 					t.Errorf("Expected %d placements to remain but found %d", expected, actual)
@@ -179,8 +179,8 @@ func TestTilePlacements(t *testing.T) {
 			if p == nil {
 				t.Errorf("Expected a valid placement but got nil")
 			} else {
-				if actual, expected := p.Tile.Letter, 'C'; actual != expected {
-					t.Errorf("Expected to get placement for tile %c second but got %c", expected, actual)
+				if actual, expected := p.Tile.Letter, "C"; actual != expected {
+					t.Errorf("Expected to get placement for tile %s second but got %s", expected, actual)
 				}
 				if actual, expected := len(placements), 2; actual != expected {
 					t.Errorf("Expected %d placements to remain but found %d", expected, actual)
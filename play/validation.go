@@ -5,12 +5,143 @@ import (
 	"github.com/mandykoh/scrubble/coord"
 )
 
+// FindPlacementViolations checks the intended placement of tiles on a board in
+// the same way as ValidatePlacements, but instead of stopping at the first
+// violation, returns every violation found along with the coordinates
+// involved. Violations are ordered by reason: tiles placed out of bounds
+// (including blocked positions spanned by the play), on occupied positions,
+// or overlapping other placed tiles, followed by the play not being linear,
+// leaving gaps, or not being connected. A tile placed on an occupied position
+// is treated as connected to the tile already there.
+//
+// On boards with wrapping edges, gaps and blocked positions are reported for
+// whichever of the ways around the board spanning the placements has the
+// fewest of them.
+//
+// If there are no violations, nil is returned.
+func FindPlacementViolations(placements Tiles, b *board.Board) (violations []PlacementViolation) {
+	if len(placements) == 0 {
+		return []PlacementViolation{{Reason: NoTilesPlacedReason}}
+	}
+
+	var outOfBounds, occupied, overlapping, gaps, blocked []coord.Coord
+	var onBoard Tiles
+
+	for i, p := range placements {
+		if located, ok := b.Locate(p.Coord); !ok || located != p.Coord || b.Position(p.Coord).IsBlocked() {
+			outOfBounds = append(outOfBounds, p.Coord)
+		} else if placements[:i].Find(p.Coord) != nil {
+			overlapping = append(overlapping, p.Coord)
+		} else {
+			if b.Position(p.Coord).Tile != nil {
+				occupied = append(occupied, p.Coord)
+			}
+			onBoard = append(onBoard, p)
+		}
+	}
+
+	linear := true
+	connected := false
+
+	if len(onBoard) > 0 {
+		fewest := -1
+		for _, bounds := range b.Spans(onBoard.Coords()...) {
+			if !bounds.IsLinear() {
+				linear = false
+				gaps, blocked = nil, nil
+				break
+			}
+
+			spanGaps, spanBlocked := placementGapsInSpan(onBoard, bounds, b)
+			if n := len(spanGaps) + len(spanBlocked); fewest < 0 || n < fewest {
+				gaps, blocked, fewest = spanGaps, spanBlocked, n
+			}
+			if fewest == 0 {
+				break
+			}
+		}
+		outOfBounds = append(outOfBounds, blocked...)
+
+		for _, p := range onBoard {
+			position := b.Position(p.Coord)
+			connected = connected || position.Tile != nil || position.Type.CountsAsConnected() || b.NeighbourHasTile(p.Coord)
+		}
+	}
+
+	addViolation := func(reason InvalidTilePlacementReason, coords []coord.Coord) {
+		if len(coords) > 0 {
+			violations = append(violations, PlacementViolation{reason, coords})
+		}
+	}
+
+	addViolation(PlacementOutOfBoundsReason, outOfBounds)
+	addViolation(PositionOccupiedReason, occupied)
+	addViolation(PlacementOverlapReason, overlapping)
+	if !linear {
+		addViolation(PlacementNotLinearReason, onBoard.Coords())
+	}
+	addViolation(PlacementNotContiguousReason, gaps)
+	if !connected {
+		addViolation(PlacementNotConnectedReason, onBoard.Coords())
+	}
+
+	return
+}
+
+// ValidateAllPlacements checks the intended placement of tiles on a board for
+// legality in the same way as ValidatePlacements, except that if there are
+// any violations, PlacementViolationsError is returned describing all of them
+// (see FindPlacementViolations).
+//
+// Otherwise, nil is returned, indicating that it would be safe to place the
+// given tiles on the board (word validity not withstanding).
+func ValidateAllPlacements(placements Tiles, b *board.Board) error {
+	return placementViolationsError(FindPlacementViolations(placements, b))
+}
+
+// ValidateAllPlacementsWithFirstPlay returns a PlacementValidator which checks
+// placements in the same way as ValidateAllPlacements, except that when the
+// board is empty, the specified FirstPlayValidator determines whether the
+// placements are sufficiently connected, instead of requiring that they cover
+// a starting position. A violation of the first play rules is reported along
+// with the coordinates of all of the placed tiles.
+func ValidateAllPlacementsWithFirstPlay(firstPlay FirstPlayValidator) PlacementValidator {
+	return func(placements Tiles, b *board.Board) error {
+		violations := FindPlacementViolations(placements, b)
+
+		if b.IsEmpty() && len(placements) > 0 {
+			for i, v := range violations {
+				if v.Reason == PlacementNotConnectedReason {
+					violations = append(violations[:i], violations[i+1:]...)
+					break
+				}
+			}
+
+			if err := firstPlay(placements, b); err != nil {
+				e, ok := err.(InvalidTilePlacementError)
+				if !ok {
+					return err
+				}
+				violations = append(violations, PlacementViolation{e.Reason, placements.Coords()})
+			}
+		}
+
+		return placementViolationsError(violations)
+	}
+}
+
 // ValidatePlacements checks the intended placement of tiles on a board for
 // legality. This includes: that at least one tile is placed, that tiles are
 // placed contiguously, that tiles are placed only in a straight line, that
 // there are no gaps created in the result, that tiles do not overlap with each
 // other or with tiles already on the board, and that no tiles are placed out of
-// bounds.
+// bounds. Blocked board positions are treated as being out of bounds.
+//
+// Linearity and contiguity are determined according to the board's topology,
+// so that on boards with wrapping edges, tiles may be placed across an edge.
+// Placements are valid if they can be read legally along any of the ways
+// around the board which span them.
+// Placements must always be given using on-board coordinates.
 //
 // If any violations are detected, InvalidTilePlacementError is returned with
 // the reason indicating the violation.
@@ -18,24 +149,87 @@ import (
 // Otherwise, nil is returned, indicating that it would be safe to place the
 // given tiles on the board (word validity not withstanding).
 func ValidatePlacements(placements Tiles, b *board.Board) (err error) {
-	placementsLeft := len(placements)
-	if placementsLeft == 0 {
+	if len(placements) == 0 {
 		return InvalidTilePlacementError{NoTilesPlacedReason}
 	}
 
-	bounds := placements.Bounds()
-	if !bounds.IsLinear() {
-		return InvalidTilePlacementError{PlacementNotLinearReason}
+	for i, bounds := range b.Spans(placements.Coords()...) {
+		if !bounds.IsLinear() {
+			return InvalidTilePlacementError{PlacementNotLinearReason}
+		}
+
+		spanErr := validatePlacementsInSpan(placements, bounds, b)
+		if spanErr == nil {
+			return nil
+		}
+
+		// Report the shortest span's error, unless another span would only be
+		// invalid for lack of connection (which a first play can overlook).
+		if i == 0 || spanErr == (InvalidTilePlacementError{PlacementNotConnectedReason}) {
+			err = spanErr
+		}
+	}
+
+	return
+}
+
+// ValidatePlacementsWithFirstPlay returns a PlacementValidator which checks
+// placements in the same way as ValidatePlacements, except that when the board
+// is empty, the specified FirstPlayValidator determines whether the placements
+// are sufficiently connected, instead of requiring that they cover a starting
+// position.
+func ValidatePlacementsWithFirstPlay(firstPlay FirstPlayValidator) PlacementValidator {
+	return func(placements Tiles, b *board.Board) error {
+		err := ValidatePlacements(placements, b)
+
+		if b.IsEmpty() && (err == nil || err == (InvalidTilePlacementError{PlacementNotConnectedReason})) {
+			return firstPlay(placements, b)
+		}
+
+		return err
+	}
+}
+
+func placementGapsInSpan(placements Tiles, bounds coord.Range, b *board.Board) (gaps, blocked []coord.Coord) {
+	bounds.Each(func(c coord.Coord) error {
+		position := b.Position(c)
+		c, _ = b.Locate(c)
+
+		if position == nil || position.IsBlocked() {
+			blocked = append(blocked, c)
+		} else if position.Tile == nil && placements.Find(c) == nil {
+			gaps = append(gaps, c)
+		}
+		return nil
+	})
+	return
+}
+
+func placementViolationsError(violations []PlacementViolation) error {
+	if len(violations) == 0 {
+		return nil
 	}
+	return PlacementViolationsError{violations}
+}
 
+func validatePlacementsInSpan(placements Tiles, bounds coord.Range, b *board.Board) (err error) {
+	placementsLeft := len(placements)
 	connected := false
 
+	for _, p := range placements {
+		if located, onBoard := b.Locate(p.Coord); onBoard && located != p.Coord {
+			return InvalidTilePlacementError{PlacementOutOfBoundsReason}
+		}
+	}
+
 	if err = bounds.Each(func(c coord.Coord) error {
 		position := b.Position(c)
-		if position == nil {
+		if position == nil || position.IsBlocked() {
 			return InvalidTilePlacementError{PlacementOutOfBoundsReason}
 		}
 
+		c, _ = b.Locate(c)
+
 		if placement := placements.Find(c); placement != nil {
 			if position.Tile != nil {
 				return InvalidTilePlacementError{PositionOccupiedReason}
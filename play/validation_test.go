@@ -27,9 +27,9 @@ func TestValidatePlacements(t *testing.T) {
 
 	t.Run("returns an error when any of the board positions is out of bounds", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(0, 0)).Tile = &tile.Tile{Letter: 'A', Points: 1}
+		b.Position(coord.Make(0, 0)).Tile = &tile.Tile{Letter: "A", Points: 1}
 
-		err := ValidatePlacements(Tiles{{tile.Tile{Letter: 'B', Points: 1}, coord.Make(0, -1)}}, b)
+		err := ValidatePlacements(Tiles{{tile.Tile{Letter: "B", Points: 1}, coord.Make(0, -1)}}, b)
 
 		if actual, expected := err, (InvalidTilePlacementError{Reason: PlacementOutOfBoundsReason}); actual != expected {
 			t.Errorf("Expected %v when attempting to play tiles out of bounds but got %v", expected, actual)
@@ -42,9 +42,29 @@ func TestValidatePlacements(t *testing.T) {
 		}
 	})
 
+	t.Run("returns an error when any of the board positions is blocked", func(t *testing.T) {
+		b := setupBoard()
+		b.Position(coord.Make(7, 8)).Type = board.BlockedPositionType()
+
+		err := ValidatePlacements(Tiles{{tile.Make('B', 1), coord.Make(7, 8)}}, b)
+
+		if actual, expected := err, (InvalidTilePlacementError{Reason: PlacementOutOfBoundsReason}); actual != expected {
+			t.Errorf("Expected %v when attempting to play tiles on a blocked position but got %v", expected, actual)
+		}
+
+		err = ValidatePlacements(Tiles{
+			{tile.Make('B', 1), coord.Make(7, 7)},
+			{tile.Make('A', 1), coord.Make(7, 9)},
+		}, b)
+
+		if actual, expected := err, (InvalidTilePlacementError{Reason: PlacementOutOfBoundsReason}); actual != expected {
+			t.Errorf("Expected %v when attempting to play tiles across a blocked position but got %v", expected, actual)
+		}
+	})
+
 	t.Run("returns an error when any of the board positions is already occupied", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(0, 0)).Tile = &tile.Tile{Letter: 'A', Points: 1}
+		b.Position(coord.Make(0, 0)).Tile = &tile.Tile{Letter: "A", Points: 1}
 
 		err := ValidatePlacements(Tiles{
 			{tile.Make('B', 1), coord.Make(0, 0)},
@@ -101,7 +121,7 @@ func TestValidatePlacements(t *testing.T) {
 
 	t.Run("returns an error when the placements aren't connected to at least one existing tile or on a starting position", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(0, 0)).Tile = &tile.Tile{Letter: 'A', Points: 1}
+		b.Position(coord.Make(0, 0)).Tile = &tile.Tile{Letter: "A", Points: 1}
 
 		err := ValidatePlacements(Tiles{
 			{tile.Make('M', 1), coord.Make(2, 0)},
@@ -129,4 +149,400 @@ func TestValidatePlacements(t *testing.T) {
 			t.Errorf("Expected success when playing tiles on a start position but got error %v", actual)
 		}
 	})
+
+	t.Run("with a toroidal board", func(t *testing.T) {
+
+		setupBoard := func() *board.Board {
+			b := setupBoard()
+			b.Topology = board.ToroidalTopology()
+			return b
+		}
+
+		t.Run("allows placements across the edge of the board", func(t *testing.T) {
+			b := setupBoard()
+			b.Position(coord.Make(3, 0)).Tile = &tile.Tile{Letter: "A", Points: 1}
+
+			err := ValidatePlacements(Tiles{
+				{tile.Make('B', 1), coord.Make(3, 13)},
+				{tile.Make('A', 1), coord.Make(3, 14)},
+				{tile.Make('D', 1), coord.Make(3, 1)},
+			}, b)
+
+			if actual := err; actual != nil {
+				t.Errorf("Expected success when playing tiles across the edge but got error %v", actual)
+			}
+		})
+
+		t.Run("allows placements joined by existing tiles across the edge of the board", func(t *testing.T) {
+			b := setupBoard()
+			for col := 8; col < 15; col++ {
+				b.Position(coord.Make(3, col)).Tile = &tile.Tile{Letter: "A", Points: 1}
+			}
+
+			err := ValidatePlacements(Tiles{
+				{tile.Make('B', 1), coord.Make(3, 0)},
+				{tile.Make('D', 1), coord.Make(3, 7)},
+			}, b)
+
+			if actual := err; actual != nil {
+				t.Errorf("Expected success when playing tiles joined across the edge but got error %v", actual)
+			}
+		})
+
+		t.Run("allows placements across the edge of the board when the shorter way round is blocked", func(t *testing.T) {
+			b := setupBoard()
+			b.Position(coord.Make(3, 3)).Type = board.BlockedPositionType()
+			for col := 8; col < 15; col++ {
+				b.Position(coord.Make(3, col)).Tile = &tile.Tile{Letter: "A", Points: 1}
+			}
+
+			err := ValidatePlacements(Tiles{
+				{tile.Make('B', 1), coord.Make(3, 0)},
+				{tile.Make('D', 1), coord.Make(3, 7)},
+			}, b)
+
+			if actual := err; actual != nil {
+				t.Errorf("Expected success when playing tiles around the blocked position but got error %v", actual)
+			}
+		})
+
+		t.Run("returns an error when the placements aren't contiguous in either direction", func(t *testing.T) {
+			b := setupBoard()
+			b.Position(coord.Make(3, 1)).Tile = &tile.Tile{Letter: "A", Points: 1}
+
+			err := ValidatePlacements(Tiles{
+				{tile.Make('B', 1), coord.Make(3, 0)},
+				{tile.Make('D', 1), coord.Make(3, 7)},
+			}, b)
+
+			if actual, expected := err, (InvalidTilePlacementError{Reason: PlacementNotContiguousReason}); actual != expected {
+				t.Errorf("Expected %v when attempting to play non-contiguous tiles but got %v", expected, actual)
+			}
+		})
+
+		t.Run("returns an error when the placements aren't in a straight line", func(t *testing.T) {
+			b := setupBoard()
+
+			err := ValidatePlacements(Tiles{
+				{tile.Make('B', 1), coord.Make(0, 14)},
+				{tile.Make('A', 1), coord.Make(14, 0)},
+			}, b)
+
+			if actual, expected := err, (InvalidTilePlacementError{Reason: PlacementNotLinearReason}); actual != expected {
+				t.Errorf("Expected %v when attempting to play tiles non-linearly but got %v", expected, actual)
+			}
+		})
+
+		t.Run("returns an error for placements given with off-board coordinates", func(t *testing.T) {
+			b := setupBoard()
+
+			err := ValidatePlacements(Tiles{
+				{tile.Make('B', 1), coord.Make(7, 14)},
+				{tile.Make('A', 1), coord.Make(7, 15)},
+			}, b)
+
+			if actual, expected := err, (InvalidTilePlacementError{Reason: PlacementOutOfBoundsReason}); actual != expected {
+				t.Errorf("Expected %v when attempting to play tiles at off-board coordinates but got %v", expected, actual)
+			}
+		})
+	})
+}
+
+func TestValidatePlacementsWithFirstPlay(t *testing.T) {
+
+	setupBoard := func() *board.Board {
+		b := board.WithStandardLayout()
+		return &b
+	}
+
+	t.Run("uses the first play validator instead of requiring connection when the board is empty", func(t *testing.T) {
+		b := setupBoard()
+		validate := ValidatePlacementsWithFirstPlay(FirstPlayAnywhere)
+
+		err := validate(Tiles{
+			{tile.Make('M', 1), coord.Make(2, 0)},
+			{tile.Make('A', 1), coord.Make(2, 1)},
+		}, b)
+
+		if actual := err; actual != nil {
+			t.Errorf("Expected success for an unconnected first play but got error %v", actual)
+		}
+	})
+
+	t.Run("returns errors from the first play validator when the board is empty", func(t *testing.T) {
+		b := setupBoard()
+		validate := ValidatePlacementsWithFirstPlay(FirstPlayNotSingleTile)
+
+		err := validate(Tiles{{tile.Make('M', 1), coord.Make(7, 7)}}, b)
+
+		if actual, expected := err, (InvalidTilePlacementError{Reason: FirstPlaySingleTileReason}); actual != expected {
+			t.Errorf("Expected %v but got %v", expected, actual)
+		}
+	})
+
+	t.Run("returns other placement errors before consulting the first play validator", func(t *testing.T) {
+		b := setupBoard()
+		validate := ValidatePlacementsWithFirstPlay(FirstPlayAnywhere)
+
+		err := validate(Tiles{
+			{tile.Make('B', 1), coord.Make(0, 0)},
+			{tile.Make('D', 1), coord.Make(0, 2)},
+		}, b)
+
+		if actual, expected := err, (InvalidTilePlacementError{Reason: PlacementNotContiguousReason}); actual != expected {
+			t.Errorf("Expected %v but got %v", expected, actual)
+		}
+	})
+
+	t.Run("requires connection to existing tiles when the board isn't empty", func(t *testing.T) {
+		b := setupBoard()
+		b.Position(coord.Make(7, 7)).Tile = &tile.Tile{Letter: "A", Points: 1}
+		validate := ValidatePlacementsWithFirstPlay(FirstPlayAnywhere)
+
+		err := validate(Tiles{
+			{tile.Make('M', 1), coord.Make(2, 0)},
+			{tile.Make('A', 1), coord.Make(2, 1)},
+		}, b)
+
+		if actual, expected := err, (InvalidTilePlacementError{Reason: PlacementNotConnectedReason}); actual != expected {
+			t.Errorf("Expected %v but got %v", expected, actual)
+		}
+	})
+}
+
+func TestFindPlacementViolations(t *testing.T) {
+
+	setupBoard := func() *board.Board {
+		b := board.WithStandardLayout()
+		return &b
+	}
+
+	expectViolations := func(t *testing.T, violations []PlacementViolation, expected ...PlacementViolation) {
+		t.Helper()
+
+		if actual, expectedLen := len(violations), len(expected); actual != expectedLen {
+			t.Fatalf("Expected %d violations but got %d: %v", expectedLen, actual, violations)
+		}
+		for i, e := range expected {
+			v := violations[i]
+			if actual, expected := v.Reason, e.Reason; actual != expected {
+				t.Errorf("Expected violation %d to have reason %v but got %v", i, expected, actual)
+			}
+			if actual, expected := len(v.Coords), len(e.Coords); actual != expected {
+				t.Errorf("Expected violation %d to have %d coordinates but got %v", i, expected, v.Coords)
+				continue
+			}
+			for j, c := range e.Coords {
+				if actual, expected := v.Coords[j], c; actual != expected {
+					t.Errorf("Expected violation %d to have coordinate %v but got %v", i, expected, actual)
+				}
+			}
+		}
+	}
+
+	t.Run("returns no violations for a valid placement", func(t *testing.T) {
+		b := setupBoard()
+
+		violations := FindPlacementViolations(Tiles{
+			{tile.Make('A', 1), coord.Make(7, 7)},
+			{tile.Make('B', 1), coord.Make(7, 8)},
+		}, b)
+
+		expectViolations(t, violations)
+	})
+
+	t.Run("returns a violation when no tiles are being played", func(t *testing.T) {
+		b := setupBoard()
+
+		violations := FindPlacementViolations(Tiles{}, b)
+
+		expectViolations(t, violations, PlacementViolation{Reason: NoTilesPlacedReason})
+	})
+
+	t.Run("returns the out of bounds, occupied and overlapping tiles", func(t *testing.T) {
+		b := setupBoard()
+		b.Position(coord.Make(7, 7)).Tile = &tile.Tile{Letter: "A", Points: 1}
+		b.Position(coord.Make(7, 10)).Type = board.BlockedPositionType()
+
+		violations := FindPlacementViolations(Tiles{
+			{tile.Make('B', 1), coord.Make(7, 7)},
+			{tile.Make('C', 1), coord.Make(7, 8)},
+			{tile.Make('D', 1), coord.Make(7, 8)},
+			{tile.Make('E', 1), coord.Make(7, 9)},
+			{tile.Make('F', 1), coord.Make(7, 10)},
+			{tile.Make('G', 1), coord.Make(7, 15)},
+		}, b)
+
+		expectViolations(t, violations,
+			PlacementViolation{PlacementOutOfBoundsReason, []coord.Coord{coord.Make(7, 10), coord.Make(7, 15)}},
+			PlacementViolation{PositionOccupiedReason, []coord.Coord{coord.Make(7, 7)}},
+			PlacementViolation{PlacementOverlapReason, []coord.Coord{coord.Make(7, 8)}},
+		)
+	})
+
+	t.Run("returns the blocked positions spanned by the placements as out of bounds", func(t *testing.T) {
+		b := setupBoard()
+		b.Position(coord.Make(7, 8)).Type = board.BlockedPositionType()
+
+		violations := FindPlacementViolations(Tiles{
+			{tile.Make('A', 1), coord.Make(7, 7)},
+			{tile.Make('B', 1), coord.Make(7, 9)},
+		}, b)
+
+		expectViolations(t, violations,
+			PlacementViolation{PlacementOutOfBoundsReason, []coord.Coord{coord.Make(7, 8)}},
+		)
+	})
+
+	t.Run("returns the gaps left between placements", func(t *testing.T) {
+		b := setupBoard()
+		b.Position(coord.Make(7, 9)).Tile = &tile.Tile{Letter: "A", Points: 1}
+
+		violations := FindPlacementViolations(Tiles{
+			{tile.Make('A', 1), coord.Make(7, 7)},
+			{tile.Make('B', 1), coord.Make(7, 12)},
+		}, b)
+
+		expectViolations(t, violations,
+			PlacementViolation{PlacementNotContiguousReason, []coord.Coord{coord.Make(7, 8), coord.Make(7, 10), coord.Make(7, 11)}},
+		)
+	})
+
+	t.Run("returns all placements when they aren't linear or connected", func(t *testing.T) {
+		b := setupBoard()
+
+		violations := FindPlacementViolations(Tiles{
+			{tile.Make('A', 1), coord.Make(0, 0)},
+			{tile.Make('B', 1), coord.Make(1, 1)},
+		}, b)
+
+		expectViolations(t, violations,
+			PlacementViolation{PlacementNotLinearReason, []coord.Coord{coord.Make(0, 0), coord.Make(1, 1)}},
+			PlacementViolation{PlacementNotConnectedReason, []coord.Coord{coord.Make(0, 0), coord.Make(1, 1)}},
+		)
+	})
+
+	t.Run("with a toroidal board", func(t *testing.T) {
+
+		t.Run("returns no gaps for placements contiguous across the edge", func(t *testing.T) {
+			b := setupBoard()
+			b.Topology = board.ToroidalTopology()
+			b.Position(coord.Make(3, 0)).Tile = &tile.Tile{Letter: "A", Points: 1}
+
+			violations := FindPlacementViolations(Tiles{
+				{tile.Make('B', 1), coord.Make(3, 13)},
+				{tile.Make('A', 1), coord.Make(3, 14)},
+				{tile.Make('D', 1), coord.Make(3, 1)},
+			}, b)
+
+			expectViolations(t, violations)
+		})
+
+		t.Run("returns no violations when the shorter way round is blocked but the other is clear", func(t *testing.T) {
+			b := setupBoard()
+			b.Topology = board.ToroidalTopology()
+			b.Position(coord.Make(3, 3)).Type = board.BlockedPositionType()
+			for col := 8; col < 15; col++ {
+				b.Position(coord.Make(3, col)).Tile = &tile.Tile{Letter: "A", Points: 1}
+			}
+
+			violations := FindPlacementViolations(Tiles{
+				{tile.Make('B', 1), coord.Make(3, 0)},
+				{tile.Make('D', 1), coord.Make(3, 7)},
+			}, b)
+
+			expectViolations(t, violations)
+		})
+
+		t.Run("returns the violations of the way round with the fewest", func(t *testing.T) {
+			b := setupBoard()
+			b.Topology = board.ToroidalTopology()
+			b.Position(coord.Make(3, 3)).Type = board.BlockedPositionType()
+			for col := 8; col < 14; col++ {
+				b.Position(coord.Make(3, col)).Tile = &tile.Tile{Letter: "A", Points: 1}
+			}
+
+			violations := FindPlacementViolations(Tiles{
+				{tile.Make('B', 1), coord.Make(3, 0)},
+				{tile.Make('D', 1), coord.Make(3, 7)},
+			}, b)
+
+			expectViolations(t, violations,
+				PlacementViolation{PlacementNotContiguousReason, []coord.Coord{coord.Make(3, 14)}},
+			)
+		})
+	})
+}
+
+func TestValidateAllPlacements(t *testing.T) {
+
+	t.Run("returns nil for a valid placement", func(t *testing.T) {
+		b := board.WithStandardLayout()
+
+		err := ValidateAllPlacements(Tiles{{tile.Make('A', 1), coord.Make(7, 7)}}, &b)
+
+		if err != nil {
+			t.Errorf("Expected success but got error %v", err)
+		}
+	})
+
+	t.Run("returns every violation", func(t *testing.T) {
+		b := board.WithStandardLayout()
+		b.Position(coord.Make(0, 0)).Tile = &tile.Tile{Letter: "A", Points: 1}
+
+		err := ValidateAllPlacements(Tiles{
+			{tile.Make('A', 1), coord.Make(0, 0)},
+			{tile.Make('B', 1), coord.Make(0, 3)},
+		}, &b)
+
+		if e, ok := err.(PlacementViolationsError); !ok {
+			t.Errorf("Expected a PlacementViolationsError but got %v", err)
+		} else if actual, expected := len(e.Violations), 2; actual != expected {
+			t.Errorf("Expected %d violations but got %v", expected, e.Violations)
+		}
+	})
+}
+
+func TestValidateAllPlacementsWithFirstPlay(t *testing.T) {
+
+	t.Run("uses the first play validator instead of requiring connection when the board is empty", func(t *testing.T) {
+		b := board.WithStandardLayout()
+		validate := ValidateAllPlacementsWithFirstPlay(FirstPlayAnywhere)
+
+		err := validate(Tiles{
+			{tile.Make('M', 1), coord.Make(2, 0)},
+			{tile.Make('A', 1), coord.Make(2, 1)},
+		}, &b)
+
+		if err != nil {
+			t.Errorf("Expected success for an unconnected first play but got error %v", err)
+		}
+	})
+
+	t.Run("reports first play violations along with other violations", func(t *testing.T) {
+		b := board.WithStandardLayout()
+		validate := ValidateAllPlacementsWithFirstPlay(FirstPlayMinTiles(3))
+
+		err := validate(Tiles{
+			{tile.Make('B', 1), coord.Make(0, 0)},
+			{tile.Make('D', 1), coord.Make(0, 2)},
+		}, &b)
+
+		e, ok := err.(PlacementViolationsError)
+		if !ok {
+			t.Fatalf("Expected a PlacementViolationsError but got %v", err)
+		}
+		if actual, expected := len(e.Violations), 2; actual != expected {
+			t.Fatalf("Expected %d violations but got %v", expected, e.Violations)
+		}
+		if actual, expected := e.Violations[0].Reason, PlacementNotContiguousReason; actual != expected {
+			t.Errorf("Expected first violation to be %v but got %v", expected, actual)
+		}
+		if actual, expected := e.Violations[1].Reason, FirstPlayTooFewTilesReason; actual != expected {
+			t.Errorf("Expected second violation to be %v but got %v", expected, actual)
+		}
+		if actual, expected := len(e.Violations[1].Coords), 2; actual != expected {
+			t.Errorf("Expected first play violation to include %d coordinates but got %d", expected, actual)
+		}
+	})
 }
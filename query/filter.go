@@ -0,0 +1,9 @@
+package query
+
+// Filter represents a function which determines whether a word matches some
+// criteria.
+//
+// Filters compare letters exactly, so the letters and patterns used to create
+// them should be normalized in the same way as the words being searched (see
+// dict.WordList.Normalize).
+type Filter func(word string) (matches bool)
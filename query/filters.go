@@ -0,0 +1,168 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/mandykoh/scrubble/dict"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// Blank is the character which stands for a blank tile (any letter) in the
+// letters given to Anagram and SubAnagram, and for any single letter in
+// patterns given to Pattern.
+const Blank = '?'
+
+// Wildcard is the character which stands for any sequence of letters
+// (including none) in patterns given to Pattern.
+const Wildcard = '*'
+
+// All returns a Filter which matches words that match all of the specified
+// filters.
+func All(filters ...Filter) Filter {
+	return func(word string) bool {
+		for _, f := range filters {
+			if !f(word) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Anagram returns a Filter which matches words that use exactly the specified
+// letters, in any order. Any Blank characters in the letters can stand for any
+// letter (eg "AEINRST?" matches eight letter words containing the letters of
+// "AEINRST" plus one other letter).
+//
+// Words and letters are split into the letters of tiles using the given
+// alphabet (see tile.Alphabet.Tokenise), so that a multiple character letter
+// counts as one tile. A nil alphabet treats each character as a tile.
+func Anagram(alphabet tile.Alphabet, letters string) Filter {
+	tokens := tokenise(alphabet, letters)
+	counts, blanks := letterCounts(tokens)
+	length := len(tokens)
+
+	return func(word string) bool {
+		tokens := tokenise(alphabet, word)
+		return len(tokens) == length && usesLetters(tokens, counts, blanks)
+	}
+}
+
+// Contains returns a Filter which matches words that contain all of the
+// specified letters (with repeated letters needing to appear as many times),
+// in any order and position. Words and letters are split into the letters of
+// tiles using the given alphabet, as with Anagram.
+func Contains(alphabet tile.Alphabet, letters string) Filter {
+	counts, _ := letterCounts(tokenise(alphabet, letters))
+
+	return func(word string) bool {
+		wordCounts, _ := letterCounts(tokenise(alphabet, word))
+		for l, n := range counts {
+			if wordCounts[l] < n {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Length returns a Filter which matches words with at least min and at most max
+// tiles. A max of zero or less means there is no maximum. Words are split into
+// the letters of tiles using the given alphabet, as with Anagram.
+func Length(alphabet tile.Alphabet, min, max int) Filter {
+	return func(word string) bool {
+		n := len(tokenise(alphabet, word))
+		return n >= min && (max <= 0 || n <= max)
+	}
+}
+
+// NormalizedAlphabet returns the specified alphabet with its letters normalized
+// in the same way as the words of the given word list, so that it can be used
+// to create filters for searching the list.
+func NormalizedAlphabet(words *dict.WordList, alphabet tile.Alphabet) tile.Alphabet {
+	var tiles []tile.Tile
+	for _, l := range alphabet {
+		tiles = append(tiles, tile.MakeLetters(words.Normalize(l), 1))
+	}
+	return tile.AlphabetOf(tiles...)
+}
+
+// Pattern returns a Filter which matches words that fit the specified pattern,
+// where Blank stands for any single letter and Wildcard stands for any
+// sequence of letters (eg "?A??ER" matches "LADDER" and "WAFTER", and "UN*ED"
+// matches "UNLOCKED"). Words and patterns are split into the letters of tiles
+// using the given alphabet, as with Anagram, so Blank stands for one tile.
+func Pattern(alphabet tile.Alphabet, pattern string) Filter {
+	patternTokens := tokenise(alphabet, pattern)
+
+	return func(word string) bool {
+		return matchesPattern(tokenise(alphabet, word), patternTokens)
+	}
+}
+
+// SubAnagram returns a Filter which matches words that can be made using some
+// or all of the specified letters, in any order. Any Blank characters in the
+// letters can stand for any letter. This is useful for finding the words which
+// can be played from a rack. Words and letters are split into the letters of
+// tiles using the given alphabet, as with Anagram.
+func SubAnagram(alphabet tile.Alphabet, letters string) Filter {
+	counts, blanks := letterCounts(tokenise(alphabet, letters))
+
+	return func(word string) bool {
+		return usesLetters(tokenise(alphabet, word), counts, blanks)
+	}
+}
+
+func letterCounts(letters []string) (counts map[string]int, blanks int) {
+	counts = make(map[string]int)
+	for _, l := range letters {
+		if l == string(Blank) {
+			blanks++
+		} else {
+			counts[l]++
+		}
+	}
+	return
+}
+
+func matchesPattern(word, pattern []string) bool {
+	if len(pattern) == 0 {
+		return len(word) == 0
+	}
+
+	switch pattern[0] {
+	case string(Wildcard):
+		for i := 0; i <= len(word); i++ {
+			if matchesPattern(word[i:], pattern[1:]) {
+				return true
+			}
+		}
+		return false
+	case string(Blank):
+		return len(word) > 0 && matchesPattern(word[1:], pattern[1:])
+	default:
+		return len(word) > 0 && word[0] == pattern[0] && matchesPattern(word[1:], pattern[1:])
+	}
+}
+
+func tokenise(alphabet tile.Alphabet, word string) []string {
+	letters, err := alphabet.Tokenise(word)
+	if err != nil {
+		return strings.Split(word, "")
+	}
+	return letters
+}
+
+func usesLetters(word []string, counts map[string]int, blanks int) bool {
+	used := make(map[string]int)
+	for _, l := range word {
+		if used[l] < counts[l] {
+			used[l]++
+		} else if blanks > 0 {
+			blanks--
+		} else {
+			return false
+		}
+	}
+	return true
+}
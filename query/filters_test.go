@@ -0,0 +1,188 @@
+package query
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mandykoh/scrubble/dict"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+var spanish = tile.Alphabet{"CH", "LL", "RR", "A", "C", "E", "H", "I", "L", "O", "R", "S"}
+
+func expectMatches(t *testing.T, f Filter, words ...string) {
+	t.Helper()
+
+	for _, w := range words {
+		if !f(w) {
+			t.Errorf("Expected '%s' to match", w)
+		}
+	}
+}
+
+func expectNoMatches(t *testing.T, f Filter, words ...string) {
+	t.Helper()
+
+	for _, w := range words {
+		if f(w) {
+			t.Errorf("Expected '%s' not to match", w)
+		}
+	}
+}
+
+func TestAll(t *testing.T) {
+
+	t.Run("matches words matching every filter", func(t *testing.T) {
+		f := All(Length(nil, 3, 3), Contains(nil, "A"))
+
+		expectMatches(t, f, "CAT")
+		expectNoMatches(t, f, "DOG", "CART")
+	})
+
+	t.Run("matches everything with no filters", func(t *testing.T) {
+		expectMatches(t, All(), "ANYTHING")
+	})
+}
+
+func TestAnagram(t *testing.T) {
+
+	t.Run("matches words using exactly the letters", func(t *testing.T) {
+		f := Anagram(nil, "AEINRST")
+
+		expectMatches(t, f, "RETAINS", "STAINER", "NASTIER")
+		expectNoMatches(t, f, "RETAIN", "STAINERS", "RETAINED")
+	})
+
+	t.Run("allows blanks to stand for any letter", func(t *testing.T) {
+		f := Anagram(nil, "AEINRST?")
+
+		expectMatches(t, f, "CANISTER", "STEARINE", "RETRAINS")
+		expectNoMatches(t, f, "RETAINS", "CANISTERS")
+	})
+
+	t.Run("counts multiple character letters as one tile", func(t *testing.T) {
+		f := Anagram(spanish, "CHIO")
+
+		expectMatches(t, f, "OCHI")
+		expectNoMatches(t, f, "CHICO", "COHI")
+	})
+
+}
+
+func TestContains(t *testing.T) {
+
+	t.Run("matches words containing all the letters", func(t *testing.T) {
+		f := Contains(nil, "QU")
+
+		expectMatches(t, f, "QUIZ", "EQUAL", "UNIQUE")
+		expectNoMatches(t, f, "QAT", "UNDO")
+	})
+
+	t.Run("requires repeated letters to appear as many times", func(t *testing.T) {
+		f := Contains(nil, "ZZ")
+
+		expectMatches(t, f, "PIZZA", "ZIZ")
+		expectNoMatches(t, f, "ZOO")
+	})
+
+	t.Run("counts multiple character letters as one tile", func(t *testing.T) {
+		f := Contains(spanish, "C")
+
+		expectMatches(t, f, "CHICO")
+		expectNoMatches(t, f, "CHILLO")
+	})
+
+}
+
+func TestLength(t *testing.T) {
+
+	t.Run("matches words within the length range", func(t *testing.T) {
+		f := Length(nil, 2, 3)
+
+		expectMatches(t, f, "AT", "CAT", "ÑUS")
+		expectNoMatches(t, f, "A", "CART")
+	})
+
+	t.Run("has no maximum when max is zero", func(t *testing.T) {
+		expectMatches(t, Length(nil, 2, 0), "ANTIDISESTABLISHMENTARIANISM")
+	})
+
+	t.Run("counts multiple character letters as one tile", func(t *testing.T) {
+		f := Length(spanish, 4, 4)
+
+		expectMatches(t, f, "CHICO", "CALLE", "PERRO")
+		expectNoMatches(t, f, "CASAS", "CHE")
+	})
+
+}
+
+func TestNormalizedAlphabet(t *testing.T) {
+
+	t.Run("normalizes letters in the same way as the word list", func(t *testing.T) {
+		words := dict.NewWordList(nil, strings.ToLower)
+		alphabet := NormalizedAlphabet(words, tile.Alphabet{"A", "LL", "CH"})
+
+		if actual, expected := len(alphabet), 3; actual != expected {
+			t.Fatalf("Expected %d letters but got %v", expected, alphabet)
+		}
+		for i, l := range []string{"ch", "ll", "a"} {
+			if actual, expected := alphabet[i], l; actual != expected {
+				t.Errorf("Expected letter %d to be '%s' but got '%s'", i, expected, actual)
+			}
+		}
+	})
+}
+
+func TestPattern(t *testing.T) {
+
+	t.Run("matches any single letter for blanks", func(t *testing.T) {
+		f := Pattern(nil, "?A??ER")
+
+		expectMatches(t, f, "LADDER", "WAFTER")
+		expectNoMatches(t, f, "LADDERS", "LEADER")
+	})
+
+	t.Run("matches any sequence of letters for wildcards", func(t *testing.T) {
+		f := Pattern(nil, "UN*ED")
+
+		expectMatches(t, f, "UNLOCKED", "UNED")
+		expectNoMatches(t, f, "UNLOCK", "LOCKED")
+	})
+
+	t.Run("treats other characters literally", func(t *testing.T) {
+		expectNoMatches(t, Pattern(nil, "A.C"), "ABC")
+	})
+
+	t.Run("matches multiple character letters as one tile", func(t *testing.T) {
+		f := Pattern(spanish, "?O")
+
+		expectMatches(t, f, "CHO", "LLO", "SO")
+		expectNoMatches(t, f, "CHIO")
+	})
+
+}
+
+func TestSubAnagram(t *testing.T) {
+
+	t.Run("matches words using some of the letters", func(t *testing.T) {
+		f := SubAnagram(nil, "AEINRST")
+
+		expectMatches(t, f, "RETAINS", "STAIN", "AT")
+		expectNoMatches(t, f, "STARES", "TREES")
+	})
+
+	t.Run("allows blanks to stand for any letter", func(t *testing.T) {
+		f := SubAnagram(nil, "CT?")
+
+		expectMatches(t, f, "CAT", "COT", "AT")
+		expectNoMatches(t, f, "CART")
+	})
+
+	t.Run("counts multiple character letters as one tile", func(t *testing.T) {
+		f := SubAnagram(spanish, "CHAO")
+
+		expectMatches(t, f, "CHA", "OCHA")
+		expectNoMatches(t, f, "CACHO")
+	})
+
+}
@@ -0,0 +1,16 @@
+package query
+
+import "github.com/mandykoh/scrubble/dict"
+
+// Find returns the words in the specified word list which match all of the
+// given filters, in sorted order.
+func Find(words *dict.WordList, filters ...Filter) (found []string) {
+	matches := All(filters...)
+
+	for _, w := range words.Words() {
+		if matches(w) {
+			found = append(found, w)
+		}
+	}
+	return
+}
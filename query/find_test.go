@@ -0,0 +1,31 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/mandykoh/scrubble/dict"
+)
+
+func TestFind(t *testing.T) {
+	words := dict.NewWordList([]string{"tar", "rat", "art", "star", "tsar", "arts", "at"}, nil)
+
+	t.Run("returns sorted words matching all filters", func(t *testing.T) {
+		found := Find(words, Anagram(nil, "rta"))
+
+		expected := []string{"art", "rat", "tar"}
+		if actual, expectedLen := len(found), len(expected); actual != expectedLen {
+			t.Fatalf("Expected %d words but found %d: %v", expectedLen, actual, found)
+		}
+		for i, e := range expected {
+			if found[i] != e {
+				t.Errorf("Expected word '%s' in position %d but found '%s'", e, i, found[i])
+			}
+		}
+	})
+
+	t.Run("returns nothing when no words match", func(t *testing.T) {
+		if found := Find(words, Pattern(nil, "z*")); len(found) != 0 {
+			t.Errorf("Expected no words but found %v", found)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package query
+
+import "github.com/mandykoh/scrubble/dict"
+
+// InnerHook represents a letter which can be inserted into a word to form
+// another valid word.
+type InnerHook struct {
+
+	// Index is the position (in characters) of the word at which the letter is
+	// inserted, such that it comes before the character currently there.
+	Index int
+
+	// Letter is the letter being inserted.
+	Letter string
+
+	// Word is the word formed by inserting the letter.
+	Word string
+}
+
+// BackHooks returns the letters which can be added to the end of the specified
+// word to form another word in the word list (eg "S" and "E" for "CAR").
+func BackHooks(words *dict.WordList, word string) (hooks []string) {
+	word = words.Normalize(word)
+
+	for _, letter := range words.Letters() {
+		if words.Contains(word + letter) {
+			hooks = append(hooks, letter)
+		}
+	}
+	return
+}
+
+// FrontHooks returns the letters which can be added to the start of the
+// specified word to form another word in the word list (eg "S" for "CAR").
+func FrontHooks(words *dict.WordList, word string) (hooks []string) {
+	word = words.Normalize(word)
+
+	for _, letter := range words.Letters() {
+		if words.Contains(letter + word) {
+			hooks = append(hooks, letter)
+		}
+	}
+	return
+}
+
+// InnerHooks returns the letters which can be inserted inside the specified
+// word (but not at the start or end) to form another word in the word list (eg
+// "H" at index 1 of "CAT" to form "CHAT"). Where inserting the same letter at
+// different positions forms the same word, only the first is returned.
+func InnerHooks(words *dict.WordList, word string) (hooks []InnerHook) {
+	characters := []rune(words.Normalize(word))
+	formed := make(map[string]bool)
+
+	for i := 1; i < len(characters); i++ {
+		before, after := string(characters[:i]), string(characters[i:])
+
+		for _, letter := range words.Letters() {
+			w := before + letter + after
+			if !formed[w] && words.Contains(w) {
+				formed[w] = true
+				hooks = append(hooks, InnerHook{Index: i, Letter: letter, Word: w})
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,66 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/mandykoh/scrubble/dict"
+)
+
+func TestHooks(t *testing.T) {
+	words := dict.NewWordList([]string{
+		"car", "cars", "care", "scar", "cat", "chat", "coat", "cart", "at", "eat", "oat", "tat",
+	}, dict.FoldCase("en"))
+
+	expectLetters := func(t *testing.T, letters []string, expected ...string) {
+		t.Helper()
+
+		if actual, expectedLen := len(letters), len(expected); actual != expectedLen {
+			t.Fatalf("Expected %d letters but found %d: %v", expectedLen, actual, letters)
+		}
+		for i, e := range expected {
+			if letters[i] != e {
+				t.Errorf("Expected letter '%s' in position %d but found '%s'", e, i, letters[i])
+			}
+		}
+	}
+
+	t.Run("BackHooks()", func(t *testing.T) {
+
+		t.Run("returns letters which can be added to the end", func(t *testing.T) {
+			expectLetters(t, BackHooks(words, "CAR"), "e", "s", "t")
+		})
+
+		t.Run("returns nothing for a word without back hooks", func(t *testing.T) {
+			expectLetters(t, BackHooks(words, "chat"))
+		})
+	})
+
+	t.Run("FrontHooks()", func(t *testing.T) {
+
+		t.Run("returns letters which can be added to the start", func(t *testing.T) {
+			expectLetters(t, FrontHooks(words, "AT"), "c", "e", "o", "t")
+		})
+	})
+
+	t.Run("InnerHooks()", func(t *testing.T) {
+
+		t.Run("returns letters which can be inserted inside the word", func(t *testing.T) {
+			hooks := InnerHooks(words, "CAT")
+
+			expected := []InnerHook{
+				{Index: 1, Letter: "h", Word: "chat"},
+				{Index: 1, Letter: "o", Word: "coat"},
+				{Index: 2, Letter: "r", Word: "cart"},
+			}
+
+			if actual, expectedLen := len(hooks), len(expected); actual != expectedLen {
+				t.Fatalf("Expected %d hooks but found %d: %v", expectedLen, actual, hooks)
+			}
+			for i, e := range expected {
+				if hooks[i] != e {
+					t.Errorf("Expected hook %v in position %d but found %v", e, i, hooks[i])
+				}
+			}
+		})
+	})
+}
@@ -0,0 +1,13 @@
+package ratings
+
+import "fmt"
+
+// DuplicatePlayerError indicates that a result couldn't be recorded because it
+// names the same player more than once.
+type DuplicatePlayerError struct {
+	Name string
+}
+
+func (e DuplicatePlayerError) Error() string {
+	return fmt.Sprintf("%#v", e)
+}
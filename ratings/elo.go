@@ -0,0 +1,54 @@
+package ratings
+
+import "math"
+
+// Elo implements the Elo rating system. In multiplayer games, each player's
+// rating is adjusted for the pairwise outcome against every other player, with
+// the K-factor shared between those outcomes.
+type Elo struct {
+
+	// K is the maximum rating change for a single game (eg 32).
+	K float64
+
+	// InitialRating is the rating of new players (eg 1500).
+	InitialRating float64
+
+	// SpreadScale makes outcomes spread-aware when greater than zero (see
+	// Result.Outcome).
+	SpreadScale float64
+}
+
+// DefaultElo returns an Elo system with a K-factor of 32 and new players rated
+// at 1500.
+func DefaultElo() Elo {
+	return Elo{K: 32, InitialRating: 1500}
+}
+
+// Initial returns the rating of a player who hasn't played any games.
+func (e Elo) Initial() Rating {
+	return Rating{Value: e.InitialRating}
+}
+
+// Update returns the new ratings of the players of the specified result.
+func (e Elo) Update(before []Rating, result Result) []Rating {
+	after := make([]Rating, len(before))
+	opponents := float64(len(before) - 1)
+
+	for i, r := range before {
+		change := 0.0
+		for j, o := range before {
+			if i != j {
+				expected := 1 / (1 + math.Pow(10, (o.Value-r.Value)/400))
+				change += result.Outcome(i, j, e.SpreadScale) - expected
+			}
+		}
+
+		after[i] = r
+		if opponents > 0 {
+			after[i].Value += e.K * change / opponents
+		}
+		after[i].Games++
+	}
+
+	return after
+}
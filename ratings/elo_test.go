@@ -0,0 +1,59 @@
+package ratings
+
+import (
+	"math"
+	"testing"
+)
+
+func TestElo(t *testing.T) {
+	elo := DefaultElo()
+
+	t.Run(".Initial()", func(t *testing.T) {
+
+		t.Run("returns the initial rating", func(t *testing.T) {
+			if actual, expected := elo.Initial(), (Rating{Value: 1500}); actual != expected {
+				t.Errorf("Expected %v but got %v", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".Update()", func(t *testing.T) {
+
+		t.Run("moves ratings by half the K-factor between equal players", func(t *testing.T) {
+			after := elo.Update([]Rating{elo.Initial(), elo.Initial()}, Result{Scores: []int{400, 300}})
+
+			if actual, expected := after[0].Value, 1516.0; actual != expected {
+				t.Errorf("Expected winner rating %v but got %v", expected, actual)
+			}
+			if actual, expected := after[1].Value, 1484.0; actual != expected {
+				t.Errorf("Expected loser rating %v but got %v", expected, actual)
+			}
+			if actual, expected := after[0].Games, 1; actual != expected {
+				t.Errorf("Expected %d games but got %d", expected, actual)
+			}
+		})
+
+		t.Run("shares the K-factor between opponents in multiplayer games", func(t *testing.T) {
+			before := []Rating{elo.Initial(), elo.Initial(), elo.Initial()}
+			after := elo.Update(before, Result{Scores: []int{400, 300, 200}})
+
+			if actual, expected := after[0].Value, 1516.0; actual != expected {
+				t.Errorf("Expected first place rating %v but got %v", expected, actual)
+			}
+			if actual, expected := after[1].Value, 1500.0; actual != expected {
+				t.Errorf("Expected second place rating %v but got %v", expected, actual)
+			}
+		})
+
+		t.Run("changes ratings less for expected results", func(t *testing.T) {
+			after := elo.Update([]Rating{{Value: 1900}, {Value: 1500}}, Result{Scores: []int{400, 300}})
+
+			if change := after[0].Value - 1900; change <= 0 || change >= 16 {
+				t.Errorf("Expected a small rating gain but got %v", change)
+			}
+			if total := after[0].Value + after[1].Value; math.Abs(total-3400) > 1e-9 {
+				t.Errorf("Expected total rating to be conserved but got %v", total)
+			}
+		})
+	})
+}
@@ -0,0 +1,136 @@
+package ratings
+
+import "math"
+
+// glicko2Scale is the factor for converting between the Glicko and Glicko-2
+// rating scales.
+const glicko2Scale = 173.7178
+
+// Glicko2 implements the Glicko-2 rating system, as described by Mark
+// Glickman. Each game is treated as a rating period for its players, with
+// multiplayer games being a set of pairwise outcomes against every other
+// player.
+type Glicko2 struct {
+
+	// Tau constrains the change in volatility over time (eg 0.5). Smaller
+	// values prevent volatility from changing by large amounts.
+	Tau float64
+
+	// InitialRating, InitialDeviation, and InitialVolatility make up the rating
+	// of new players (eg 1500, 350, and 0.06).
+	InitialRating     float64
+	InitialDeviation  float64
+	InitialVolatility float64
+
+	// SpreadScale makes outcomes spread-aware when greater than zero (see
+	// Result.Outcome).
+	SpreadScale float64
+}
+
+// DefaultGlicko2 returns a Glicko2 system with the values recommended by
+// Glickman: a tau of 0.5, and new players rated at 1500 with a deviation of 350
+// and a volatility of 0.06.
+func DefaultGlicko2() Glicko2 {
+	return Glicko2{
+		Tau:               0.5,
+		InitialRating:     1500,
+		InitialDeviation:  350,
+		InitialVolatility: 0.06,
+	}
+}
+
+// Initial returns the rating of a player who hasn't played any games.
+func (gl Glicko2) Initial() Rating {
+	return Rating{
+		Value:      gl.InitialRating,
+		Deviation:  gl.InitialDeviation,
+		Volatility: gl.InitialVolatility,
+	}
+}
+
+// Update returns the new ratings of the players of the specified result.
+func (gl Glicko2) Update(before []Rating, result Result) []Rating {
+	after := make([]Rating, len(before))
+
+	for i, r := range before {
+		mu := (r.Value - gl.InitialRating) / glicko2Scale
+		phi := r.Deviation / glicko2Scale
+
+		var vInverse, improvement float64
+		for j, o := range before {
+			if i == j {
+				continue
+			}
+			muJ := (o.Value - gl.InitialRating) / glicko2Scale
+			gJ := glicko2G(o.Deviation / glicko2Scale)
+			expected := 1 / (1 + math.Exp(-gJ*(mu-muJ)))
+
+			vInverse += gJ * gJ * expected * (1 - expected)
+			improvement += gJ * (result.Outcome(i, j, gl.SpreadScale) - expected)
+		}
+
+		after[i] = r
+		after[i].Games++
+
+		if vInverse == 0 {
+			continue
+		}
+
+		v := 1 / vInverse
+		delta := v * improvement
+		sigma := gl.volatility(delta, phi, v, r.Volatility)
+
+		phiStar := math.Sqrt(phi*phi + sigma*sigma)
+		phiNew := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+		muNew := mu + phiNew*phiNew*improvement
+
+		after[i].Value = glicko2Scale*muNew + gl.InitialRating
+		after[i].Deviation = glicko2Scale * phiNew
+		after[i].Volatility = sigma
+	}
+
+	return after
+}
+
+// volatility determines the new volatility of a player using the iterative
+// (Illinois) algorithm from step 5 of Glickman's description.
+func (gl Glicko2) volatility(delta, phi, v, sigma float64) float64 {
+	const epsilon = 0.000001
+
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/(gl.Tau*gl.Tau)
+	}
+
+	bigA := a
+	var bigB float64
+	if delta*delta > phi*phi+v {
+		bigB = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*gl.Tau) < 0 {
+			k++
+		}
+		bigB = a - k*gl.Tau
+	}
+
+	fA, fB := f(bigA), f(bigB)
+	for math.Abs(bigB-bigA) > epsilon {
+		bigC := bigA + (bigA-bigB)*fA/(fB-fA)
+		fC := f(bigC)
+		if fC*fB <= 0 {
+			bigA, fA = bigB, fB
+		} else {
+			fA /= 2
+		}
+		bigB, fB = bigC, fC
+	}
+
+	return math.Exp(bigA / 2)
+}
+
+func glicko2G(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
@@ -0,0 +1,55 @@
+package ratings
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGlicko2(t *testing.T) {
+	gl := DefaultGlicko2()
+
+	expectClose := func(t *testing.T, description string, actual, expected, tolerance float64) {
+		t.Helper()
+
+		if math.Abs(actual-expected) > tolerance {
+			t.Errorf("Expected %s of %v but got %v", description, expected, actual)
+		}
+	}
+
+	t.Run(".Initial()", func(t *testing.T) {
+
+		t.Run("returns the initial rating", func(t *testing.T) {
+			if actual, expected := gl.Initial(), (Rating{Value: 1500, Deviation: 350, Volatility: 0.06}); actual != expected {
+				t.Errorf("Expected %v but got %v", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".Update()", func(t *testing.T) {
+
+		t.Run("matches Glickman's worked example", func(t *testing.T) {
+			before := []Rating{
+				{Value: 1500, Deviation: 200, Volatility: 0.06},
+				{Value: 1400, Deviation: 30, Volatility: 0.06},
+				{Value: 1550, Deviation: 100, Volatility: 0.06},
+				{Value: 1700, Deviation: 300, Volatility: 0.06},
+			}
+			after := gl.Update(before, Result{Scores: []int{300, 200, 350, 400}})
+
+			expectClose(t, "rating", after[0].Value, 1464.06, 0.01)
+			expectClose(t, "deviation", after[0].Deviation, 151.52, 0.01)
+			expectClose(t, "volatility", after[0].Volatility, 0.05999, 0.00001)
+		})
+
+		t.Run("reduces deviation after a game", func(t *testing.T) {
+			after := gl.Update([]Rating{gl.Initial(), gl.Initial()}, Result{Scores: []int{400, 300}})
+
+			if after[0].Deviation >= 350 {
+				t.Errorf("Expected deviation to decrease but got %v", after[0].Deviation)
+			}
+			if after[0].Value <= 1500 || after[1].Value >= 1500 {
+				t.Errorf("Expected winner to gain and loser to lose but got %v and %v", after[0].Value, after[1].Value)
+			}
+		})
+	})
+}
@@ -0,0 +1,14 @@
+package ratings
+
+import "fmt"
+
+// MismatchedScoresError indicates that a result couldn't be recorded because it
+// doesn't have exactly one score for each player.
+type MismatchedScoresError struct {
+	Players int
+	Scores  int
+}
+
+func (e MismatchedScoresError) Error() string {
+	return fmt.Sprintf("%#v", e)
+}
@@ -0,0 +1,24 @@
+package ratings
+
+// Rating represents a player's rating at a point in time. Systems which don't
+// track the reliability of ratings (such as Elo) leave Deviation and Volatility
+// at zero.
+type Rating struct {
+	Value      float64 `json:"value"`
+	Deviation  float64 `json:"deviation,omitempty"`
+	Volatility float64 `json:"volatility,omitempty"`
+	Games      int     `json:"games"`
+}
+
+// System represents a rating system, which determines how players' ratings
+// change as a result of games.
+type System interface {
+
+	// Initial returns the rating of a player who hasn't played any games.
+	Initial() Rating
+
+	// Update returns the new ratings of the players of the specified result,
+	// given their ratings before the game (indexed the same as the result's
+	// players).
+	Update(before []Rating, result Result) (after []Rating)
+}
@@ -0,0 +1,76 @@
+package ratings
+
+import (
+	"time"
+
+	"github.com/mandykoh/scrubble/game"
+)
+
+// Result represents the outcome of a completed game, with the final score of
+// each player.
+type Result struct {
+	Date    time.Time `json:"date"`
+	Players []string  `json:"players"`
+	Scores  []int     `json:"scores"`
+}
+
+// ResultFromGame returns the Result of the specified completed game, with the
+// given names for the players in each seat.
+func ResultFromGame(g *game.Game, players []string, date time.Time) Result {
+	r := Result{Date: date, Players: players}
+	for _, s := range g.Seats {
+		r.Scores = append(r.Scores, s.Score)
+	}
+	return r
+}
+
+// Outcome returns the outcome of the game between the players at the specified
+// indices, from the perspective of the first: 1 for a win, 0.5 for a draw, and
+// 0 for a loss. Multiplayer games are treated as a set of such pairwise
+// outcomes.
+//
+// If spreadScale is greater than zero, the outcome is spread-aware, and varies
+// with the points spread between the players instead: a spread of spreadScale
+// points or more counts as a full win (or loss), with smaller spreads counting
+// proportionally.
+func (r Result) Outcome(player, opponent int, spreadScale float64) float64 {
+	spread := float64(r.Scores[player] - r.Scores[opponent])
+
+	if spreadScale > 0 {
+		outcome := 0.5 + spread/(2*spreadScale)
+		if outcome < 0 {
+			return 0
+		} else if outcome > 1 {
+			return 1
+		}
+		return outcome
+	}
+
+	switch {
+	case spread > 0:
+		return 1
+	case spread < 0:
+		return 0
+	default:
+		return 0.5
+	}
+}
+
+// Validate checks that the result can be recorded, returning a
+// MismatchedScoresError if it doesn't have exactly one score for each player,
+// or a DuplicatePlayerError if a player is named more than once.
+func (r Result) Validate() error {
+	if len(r.Players) != len(r.Scores) {
+		return MismatchedScoresError{Players: len(r.Players), Scores: len(r.Scores)}
+	}
+
+	seen := make(map[string]bool, len(r.Players))
+	for _, name := range r.Players {
+		if seen[name] {
+			return DuplicatePlayerError{Name: name}
+		}
+		seen[name] = true
+	}
+
+	return nil
+}
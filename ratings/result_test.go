@@ -0,0 +1,88 @@
+package ratings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mandykoh/scrubble/game"
+	"github.com/mandykoh/scrubble/seat"
+)
+
+func TestResultFromGame(t *testing.T) {
+
+	t.Run("takes the final scores from the game's seats", func(t *testing.T) {
+		g := &game.Game{Seats: []seat.Seat{{Score: 300}, {Score: 250}}}
+		date := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+
+		r := ResultFromGame(g, []string{"alice", "bob"}, date)
+
+		if actual, expected := r.Scores[1], 250; actual != expected {
+			t.Errorf("Expected score %d but got %d", expected, actual)
+		}
+		if actual, expected := r.Players[0], "alice"; actual != expected {
+			t.Errorf("Expected player '%s' but got '%s'", expected, actual)
+		}
+		if !r.Date.Equal(date) {
+			t.Errorf("Expected date %v but got %v", date, r.Date)
+		}
+	})
+}
+
+func TestResult(t *testing.T) {
+
+	t.Run(".Outcome()", func(t *testing.T) {
+		r := Result{Players: []string{"a", "b", "c"}, Scores: []int{400, 350, 400}}
+
+		t.Run("returns wins, losses, and draws", func(t *testing.T) {
+			cases := []struct {
+				Player, Opponent int
+				Expected         float64
+			}{
+				{0, 1, 1},
+				{1, 0, 0},
+				{0, 2, 0.5},
+			}
+
+			for _, c := range cases {
+				if actual := r.Outcome(c.Player, c.Opponent, 0); actual != c.Expected {
+					t.Errorf("Expected outcome %v for %d vs %d but got %v", c.Expected, c.Player, c.Opponent, actual)
+				}
+			}
+		})
+
+		t.Run("scales outcomes by spread when spread-aware", func(t *testing.T) {
+			if actual, expected := r.Outcome(0, 1, 100), 0.75; actual != expected {
+				t.Errorf("Expected outcome %v but got %v", expected, actual)
+			}
+			if actual, expected := r.Outcome(1, 0, 20), 0.0; actual != expected {
+				t.Errorf("Expected outcome %v but got %v", expected, actual)
+			}
+		})
+	})
+	t.Run(".Validate()", func(t *testing.T) {
+
+		t.Run("accepts a score for each of several different players", func(t *testing.T) {
+			r := Result{Players: []string{"a", "b"}, Scores: []int{400, 350}}
+
+			if err := r.Validate(); err != nil {
+				t.Errorf("Expected no error but got %v", err)
+			}
+		})
+
+		t.Run("returns an error when the players and scores don't match", func(t *testing.T) {
+			r := Result{Players: []string{"a", "b"}, Scores: []int{400}}
+
+			if actual, expected := r.Validate(), (MismatchedScoresError{Players: 2, Scores: 1}); actual != expected {
+				t.Errorf("Expected error %v but got %v", expected, actual)
+			}
+		})
+
+		t.Run("returns an error when a player is named more than once", func(t *testing.T) {
+			r := Result{Players: []string{"a", "b", "a"}, Scores: []int{400, 350, 200}}
+
+			if actual, expected := r.Validate(), (DuplicatePlayerError{Name: "a"}); actual != expected {
+				t.Errorf("Expected error %v but got %v", expected, actual)
+			}
+		})
+	})
+}
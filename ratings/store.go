@@ -0,0 +1,135 @@
+package ratings
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// HistoryEntry represents a player's rating after a game.
+type HistoryEntry struct {
+	Date   time.Time `json:"date"`
+	Rating Rating    `json:"rating"`
+	Score  int       `json:"score"`
+}
+
+// PlayerRecord represents a player's current rating and rating history.
+type PlayerRecord struct {
+	Name    string         `json:"name"`
+	Rating  Rating         `json:"rating"`
+	History []HistoryEntry `json:"history"`
+}
+
+// Store is a file-backed collection of player ratings and rating histories.
+// Changes are only written to the file when Save is called.
+type Store struct {
+	path string
+
+	// System is the name of the rating system used for the store's ratings,
+	// for callers to ensure that ratings from different systems aren't mixed.
+	System string `json:"system"`
+
+	Players map[string]*PlayerRecord `json:"players"`
+	Results []Result                 `json:"results"`
+}
+
+// Open returns the Store saved in the file at the specified path. If the file
+// doesn't exist, a new empty Store is returned which will be saved to that
+// path.
+func Open(path string) (*Store, error) {
+	s := &Store{path: path, Players: make(map[string]*PlayerRecord)}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	if s.Players == nil {
+		s.Players = make(map[string]*PlayerRecord)
+	}
+
+	return s, nil
+}
+
+// Ladder returns the records of all players, ordered from highest to lowest
+// rated (and then by name).
+func (s *Store) Ladder() []PlayerRecord {
+	ladder := make([]PlayerRecord, 0, len(s.Players))
+	for _, p := range s.Players {
+		ladder = append(ladder, *p)
+	}
+
+	sort.Slice(ladder, func(i, j int) bool {
+		if ladder[i].Rating.Value != ladder[j].Rating.Value {
+			return ladder[i].Rating.Value > ladder[j].Rating.Value
+		}
+		return ladder[i].Name < ladder[j].Name
+	})
+
+	return ladder
+}
+
+// Record updates the ratings of the players of the specified result using the
+// given rating system, adding new players with the system's initial rating.
+//
+// If the result is invalid (see Result.Validate), the store is left unchanged
+// and the validation error is returned.
+func (s *Store) Record(system System, result Result) error {
+	if err := result.Validate(); err != nil {
+		return err
+	}
+
+	before := make([]Rating, len(result.Players))
+	for i, name := range result.Players {
+		p, ok := s.Players[name]
+		if !ok {
+			p = &PlayerRecord{Name: name, Rating: system.Initial()}
+			s.Players[name] = p
+		}
+		before[i] = p.Rating
+	}
+
+	after := system.Update(before, result)
+
+	for i, name := range result.Players {
+		p := s.Players[name]
+		p.Rating = after[i]
+		p.History = append(p.History, HistoryEntry{Date: result.Date, Rating: after[i], Score: result.Scores[i]})
+	}
+
+	s.Results = append(s.Results, result)
+	return nil
+}
+
+// Save writes the store to its file. The file is replaced atomically, so that
+// it isn't left partially written if saving fails.
+func (s *Store) Save() error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
@@ -0,0 +1,129 @@
+package ratings
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStore(t *testing.T) {
+
+	withTempDir := func(t *testing.T, f func(dir string)) {
+		dir, err := ioutil.TempDir("", "ratings")
+		if err != nil {
+			t.Fatalf("Unexpected error creating temp dir: %v", err)
+		}
+		defer os.RemoveAll(dir)
+
+		f(dir)
+	}
+
+	t.Run("Open()", func(t *testing.T) {
+
+		t.Run("returns an empty store when the file doesn't exist", func(t *testing.T) {
+			withTempDir(t, func(dir string) {
+				s, err := Open(filepath.Join(dir, "ratings.json"))
+
+				if err != nil {
+					t.Fatalf("Expected no error but got %v", err)
+				}
+				if actual, expected := len(s.Players), 0; actual != expected {
+					t.Errorf("Expected %d players but got %d", expected, actual)
+				}
+			})
+		})
+	})
+
+	t.Run(".Record()", func(t *testing.T) {
+
+		t.Run("adds new players with initial ratings and records history", func(t *testing.T) {
+			s := &Store{Players: make(map[string]*PlayerRecord)}
+			date := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+
+			s.Record(DefaultElo(), Result{Date: date, Players: []string{"alice", "bob"}, Scores: []int{400, 300}})
+			s.Record(DefaultElo(), Result{Date: date, Players: []string{"alice", "carol"}, Scores: []int{350, 360}})
+
+			if actual, expected := len(s.Players["alice"].History), 2; actual != expected {
+				t.Fatalf("Expected %d history entries but got %d", expected, actual)
+			}
+			if actual, expected := s.Players["alice"].History[1].Score, 350; actual != expected {
+				t.Errorf("Expected score %d but got %d", expected, actual)
+			}
+			if actual, expected := s.Players["bob"].Rating.Value, 1484.0; actual != expected {
+				t.Errorf("Expected rating %v but got %v", expected, actual)
+			}
+			if actual, expected := len(s.Results), 2; actual != expected {
+				t.Errorf("Expected %d results but got %d", expected, actual)
+			}
+		})
+		t.Run("returns an error and leaves the store unchanged for an invalid result", func(t *testing.T) {
+			s := &Store{Players: make(map[string]*PlayerRecord)}
+
+			err := s.Record(DefaultElo(), Result{Players: []string{"alice", "bob"}, Scores: []int{400}})
+
+			if actual, expected := err, (MismatchedScoresError{Players: 2, Scores: 1}); actual != expected {
+				t.Errorf("Expected error %v but got %v", expected, actual)
+			}
+			if actual, expected := len(s.Players), 0; actual != expected {
+				t.Errorf("Expected %d players but got %d", expected, actual)
+			}
+			if actual, expected := len(s.Results), 0; actual != expected {
+				t.Errorf("Expected %d results but got %d", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".Ladder()", func(t *testing.T) {
+
+		t.Run("orders players by rating and then name", func(t *testing.T) {
+			s := &Store{Players: map[string]*PlayerRecord{
+				"bob":   {Name: "bob", Rating: Rating{Value: 1500}},
+				"alice": {Name: "alice", Rating: Rating{Value: 1500}},
+				"carol": {Name: "carol", Rating: Rating{Value: 1600}},
+			}}
+
+			ladder := s.Ladder()
+
+			for i, expected := range []string{"carol", "alice", "bob"} {
+				if actual := ladder[i].Name; actual != expected {
+					t.Errorf("Expected '%s' at position %d but got '%s'", expected, i, actual)
+				}
+			}
+		})
+	})
+
+	t.Run(".Save()", func(t *testing.T) {
+
+		t.Run("writes a store which can be reopened", func(t *testing.T) {
+			withTempDir(t, func(dir string) {
+				path := filepath.Join(dir, "ratings.json")
+
+				s, _ := Open(path)
+				s.System = "elo"
+				s.Record(DefaultElo(), Result{Players: []string{"alice", "bob"}, Scores: []int{400, 300}})
+
+				if err := s.Save(); err != nil {
+					t.Fatalf("Expected no error but got %v", err)
+				}
+
+				reopened, err := Open(path)
+				if err != nil {
+					t.Fatalf("Expected no error but got %v", err)
+				}
+				if actual, expected := reopened.System, "elo"; actual != expected {
+					t.Errorf("Expected system '%s' but got '%s'", expected, actual)
+				}
+				if actual, expected := reopened.Players["alice"].Rating, s.Players["alice"].Rating; actual != expected {
+					t.Errorf("Expected rating %v but got %v", expected, actual)
+				}
+
+				files, _ := ioutil.ReadDir(dir)
+				if actual, expected := len(files), 1; actual != expected {
+					t.Errorf("Expected %d file but found %d", expected, actual)
+				}
+			})
+		})
+	})
+}
@@ -0,0 +1,33 @@
+package scoring
+
+// FullRackBonus returns a BonusSchedule which awards the specified number of
+// bonus points for playing all the tiles on a full rack of the given size.
+func FullRackBonus(rackSize, bonus int) BonusSchedule {
+	return func(tilesPlayed int) int {
+		if tilesPlayed >= rackSize {
+			return bonus
+		}
+		return 0
+	}
+}
+
+// TieredBonus returns a BonusSchedule which awards bonus points according to
+// the number of tiles played, using the specified tiers of bonus points by
+// number of tiles. A play receives the bonus of the largest tier with no more
+// tiles than were played; plays with fewer tiles than any tier receive no
+// bonus.
+//
+// For example, TieredBonus(map[int]int{7: 50, 8: 75}) awards 50 points for
+// playing seven tiles and 75 points for playing eight or more.
+func TieredBonus(tiers map[int]int) BonusSchedule {
+	return func(tilesPlayed int) (bonus int) {
+		best := -1
+		for tiles, b := range tiers {
+			if tiles <= tilesPlayed && tiles > best {
+				best = tiles
+				bonus = b
+			}
+		}
+		return
+	}
+}
@@ -0,0 +1,50 @@
+package scoring
+
+import "testing"
+
+func TestFullRackBonus(t *testing.T) {
+
+	t.Run("awards the bonus only for playing a full rack", func(t *testing.T) {
+		schedule := FullRackBonus(8, 50)
+
+		cases := []struct {
+			TilesPlayed   int
+			ExpectedBonus int
+		}{
+			{1, 0},
+			{7, 0},
+			{8, 50},
+			{9, 50},
+		}
+
+		for _, c := range cases {
+			if actual, expected := schedule(c.TilesPlayed), c.ExpectedBonus; actual != expected {
+				t.Errorf("Expected bonus of %d for %d tiles but got %d", expected, c.TilesPlayed, actual)
+			}
+		}
+	})
+}
+
+func TestTieredBonus(t *testing.T) {
+
+	t.Run("awards the bonus of the largest tier reached", func(t *testing.T) {
+		schedule := TieredBonus(map[int]int{7: 50, 8: 75})
+
+		cases := []struct {
+			TilesPlayed   int
+			ExpectedBonus int
+		}{
+			{0, 0},
+			{6, 0},
+			{7, 50},
+			{8, 75},
+			{9, 75},
+		}
+
+		for _, c := range cases {
+			if actual, expected := schedule(c.TilesPlayed), c.ExpectedBonus; actual != expected {
+				t.Errorf("Expected bonus of %d for %d tiles but got %d", expected, c.TilesPlayed, actual)
+			}
+		}
+	})
+}
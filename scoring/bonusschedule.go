@@ -0,0 +1,5 @@
+package scoring
+
+// BonusSchedule represents a function which determines the bonus points
+// awarded for a play, according to the number of tiles played.
+type BonusSchedule func(tilesPlayed int) (bonus int)
@@ -16,6 +16,10 @@ import (
 // tiles on a full rack in one turn.
 const MaxRackTilesBonus = 50
 
+// AppMaxRackTilesBonus is the number of bonus points awarded for playing all
+// the tiles on a full rack in one turn in the app variant.
+const AppMaxRackTilesBonus = 35
+
 // ScoreEndGame determines the final scores to be added to each player's total
 // after the last play of the game is made.
 func ScoreEndGame(lastPlay *history.Entry, seats []seat.Seat) (finalScores []int) {
@@ -50,14 +54,39 @@ func ScoreEndGame(lastPlay *history.Entry, seats []seat.Seat) (finalScores []int
 
 // ScoreWords determines the scoring from a set of proposed tile placements.
 // This assumes that the tiles are being placed in valid positions according to
-// the game rules. This implements standard scoring rules.
+// the game rules. This implements standard scoring rules. Blocked board
+// positions act as word boundaries.
 //
 // If a score cannot be determined because not all formed words are valid, an
 // InvalidWordError is returned containing the invalid words.
 //
 // Otherwise, the total score is returned along with the words that would be
-// formed on the board should the tiles be placed.
+// formed on the board should the tiles be placed. On boards with wrapping edges,
+// the ranges of formed words may extend past the edges of the board (see
+// board.Board.Locate).
 func ScoreWords(placements play.Tiles, board *board.Board, isWordValid dict.Dictionary) (score int, words []play.Word, err error) {
+	return ScoreWordsWithBonus(tile.MaxRackTiles, MaxRackTilesBonus)(placements, board, isWordValid)
+}
+
+// ScoreWordsWithBonus returns a WordScorer which scores plays in the same way
+// as ScoreWords, except that the specified number of bonus points is awarded
+// for playing all the tiles on a full rack of the specified size (instead of
+// MaxRackTilesBonus for tile.MaxRackTiles tiles).
+func ScoreWordsWithBonus(rackSize, bonus int) WordScorer {
+	return ScoreWordsWithSchedule(FullRackBonus(rackSize, bonus))
+}
+
+// ScoreWordsWithSchedule returns a WordScorer which scores plays in the same
+// way as ScoreWords, except that bonus points are awarded according to the
+// specified schedule (eg for games with larger racks, or with bonuses for
+// playing different numbers of tiles).
+func ScoreWordsWithSchedule(schedule BonusSchedule) WordScorer {
+	return func(placements play.Tiles, board *board.Board, isWordValid dict.Dictionary) (int, []play.Word, error) {
+		return scoreWords(placements, board, isWordValid, schedule)
+	}
+}
+
+func scoreWords(placements play.Tiles, board *board.Board, isWordValid dict.Dictionary, schedule BonusSchedule) (score int, words []play.Word, err error) {
 	var wordSpans []coord.Range
 	findSpans(coord.Coord.West, coord.Coord.East, placements, &wordSpans, board)
 	findSpans(coord.Coord.North, coord.Coord.South, placements, &wordSpans, board)
@@ -65,7 +94,7 @@ func ScoreWords(placements play.Tiles, board *board.Board, isWordValid dict.Dict
 	score, words = spansToPlayedWords(wordSpans, placements, board)
 
 	var singleTileSpans []coord.Range
-	findUnspanned(placements, wordSpans, &singleTileSpans)
+	findUnspanned(placements, wordSpans, &singleTileSpans, board)
 	_, invalidWords := spansToPlayedWords(singleTileSpans, placements, board)
 
 	for _, w := range words {
@@ -78,9 +107,7 @@ func ScoreWords(placements play.Tiles, board *board.Board, isWordValid dict.Dict
 		return 0, nil, play.InvalidWordError{Words: invalidWords}
 	}
 
-	if len(placements) >= tile.MaxRackTiles {
-		score += MaxRackTilesBonus
-	}
+	score += schedule(len(placements))
 
 	return
 }
@@ -90,8 +117,8 @@ func findSpans(growMinDir, growMaxDir func(coord.Coord) coord.Coord, placements
 
 	for p := unspanned.TakeLast(); p != nil; p = unspanned.TakeLast() {
 		span := coord.Range{Min: p.Coord, Max: p.Coord}
-		growSpan(&span.Min, growMinDir, &unspanned, board)
-		growSpan(&span.Max, growMaxDir, &unspanned, board)
+		growSpan(&span.Min, growMinDir, span.Max, &unspanned, board)
+		growSpan(&span.Max, growMaxDir, span.Min, &unspanned, board)
 
 		if span.Min.Row != span.Max.Row || span.Min.Column != span.Max.Column {
 			*results = append(*results, span)
@@ -99,12 +126,12 @@ func findSpans(growMinDir, growMaxDir func(coord.Coord) coord.Coord, placements
 	}
 }
 
-func findUnspanned(placements play.Tiles, wordSpans []coord.Range, result *[]coord.Range) {
+func findUnspanned(placements play.Tiles, wordSpans []coord.Range, result *[]coord.Range, board *board.Board) {
 	for _, p := range placements {
 		inSpan := false
 
 		for _, s := range wordSpans {
-			if s.Includes(p.Coord) {
+			if spanIncludes(s, p.Coord, board) {
 				inSpan = true
 				break
 			}
@@ -116,17 +143,28 @@ func findUnspanned(placements play.Tiles, wordSpans []coord.Range, result *[]coo
 	}
 }
 
-func growSpan(growCoord *coord.Coord, growDir func(coord.Coord) coord.Coord, unspanned *play.Tiles, board *board.Board) {
+// growSpan extends a span's end in the specified direction for as long as
+// there are tiles (existing or being placed) to extend over. On boards with
+// wrapping edges, growth stops short of the span's opposite end so that words
+// never overlap themselves.
+func growSpan(growCoord *coord.Coord, growDir func(coord.Coord) coord.Coord, oppositeEnd coord.Coord, unspanned *play.Tiles, board *board.Board) {
+	limit, _ := board.Locate(oppositeEnd)
+
 	for {
 		c := growDir(*growCoord)
 		pos := board.Position(c)
-		if pos == nil {
+		if pos == nil || pos.IsBlocked() {
+			break
+		}
+
+		located, _ := board.Locate(c)
+		if located == limit {
 			break
 		}
 
 		if pos.Tile != nil {
 			*growCoord = c
-		} else if placement := unspanned.Take(c); placement != nil {
+		} else if placement := unspanned.Take(located); placement != nil {
 			*growCoord = c
 		} else {
 			break
@@ -134,6 +172,15 @@ func growSpan(growCoord *coord.Coord, growDir func(coord.Coord) coord.Coord, uns
 	}
 }
 
+func spanIncludes(s coord.Range, c coord.Coord, board *board.Board) (included bool) {
+	s.Each(func(sc coord.Coord) error {
+		located, _ := board.Locate(sc)
+		included = included || located == c
+		return nil
+	})
+	return
+}
+
 func spansToPlayedWords(wordSpans []coord.Range, placements play.Tiles, b *board.Board) (totalScore int, words []play.Word) {
 	for _, s := range wordSpans {
 		var playedWord = play.Word{Range: s}
@@ -148,12 +195,13 @@ func spansToPlayedWords(wordSpans []coord.Range, placements play.Tiles, b *board
 				t = position.Tile
 				playedWord.Score += t.Points
 			} else {
-				t = &placements.Find(c).Tile
+				located, _ := b.Locate(c)
+				t = &placements.Find(located).Tile
 				playedWord.Score += position.Type.ModifyTileScore(t.Points)
 				wordScoreModifiers = append(wordScoreModifiers, position.Type)
 			}
 
-			word.WriteRune(t.Letter)
+			word.WriteString(t.Letter)
 
 			return nil
 		})
@@ -22,14 +22,14 @@ func TestScoreEndGame(t *testing.T) {
 				},
 				{
 					Rack: tile.Rack{
-						{'C', 4},
-						{'D', 5},
+						tile.Make('C', 4),
+						tile.Make('D', 5),
 					},
 				},
 				{
 					Rack: tile.Rack{
-						{'E', 6},
-						{'F', 7},
+						tile.Make('E', 6),
+						tile.Make('F', 7),
 					},
 				},
 			})
@@ -55,14 +55,14 @@ func TestScoreEndGame(t *testing.T) {
 				},
 				{
 					Rack: tile.Rack{
-						{'C', 4},
-						{'D', 5},
+						tile.Make('C', 4),
+						tile.Make('D', 5),
 					},
 				},
 				{
 					Rack: tile.Rack{
-						{'E', 6},
-						{'F', 7},
+						tile.Make('E', 6),
+						tile.Make('F', 7),
 					},
 				},
 			})
@@ -124,8 +124,8 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("checks each word against dictionary for validity", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(0, 3)).Tile = &tile.Tile{Letter: 'G', Points: 2}
-		b.Position(coord.Make(2, 3)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(0, 3)).Tile = &tile.Tile{Letter: "G", Points: 2}
+		b.Position(coord.Make(2, 3)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		var wordsLookedUp []string
 
@@ -186,7 +186,7 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("counts entire horizontal word connected to existing tile", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(2, 3)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(2, 3)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(2, 4)},
@@ -203,6 +203,45 @@ func TestScoreWords(t *testing.T) {
 		}
 	})
 
+	t.Run("treats blocked positions as word boundaries", func(t *testing.T) {
+		b := setupBoard()
+		b.Position(coord.Make(1, 1)).Type = board.BlockedPositionType()
+		b.Position(coord.Make(1, 5)).Type = board.BlockedPositionType()
+
+		score, words, err := ScoreWords(play.Tiles{
+			{tile.Make('D', 2), coord.Make(1, 2)},
+			{tile.Make('O', 1), coord.Make(1, 3)},
+			{tile.Make('G', 2), coord.Make(1, 4)},
+		}, b, dictionary)
+
+		if err != nil {
+			t.Errorf("Expected success but got error %v", err)
+		} else {
+			if actual, expected := score, 5; actual != expected {
+				t.Errorf("Expected a total score of %d but got %d", expected, actual)
+			}
+			expectFormedWords(t, words, play.Word{"DOG", 5, coord.Range{coord.Make(1, 2), coord.Make(1, 4)}})
+		}
+	})
+
+	t.Run("forms words from multiple character tiles", func(t *testing.T) {
+		b := setupBoard()
+
+		score, words, err := ScoreWords(play.Tiles{
+			{tile.MakeLetters("CH", 5), coord.Make(1, 2)},
+			{tile.Make('E', 1), coord.Make(1, 3)},
+		}, b, dictionary)
+
+		if err != nil {
+			t.Errorf("Expected success but got error %v", err)
+		} else {
+			if actual, expected := score, 6; actual != expected {
+				t.Errorf("Expected a total score of %d but got %d", expected, actual)
+			}
+			expectFormedWords(t, words, play.Word{"CHE", 6, coord.Range{coord.Make(1, 2), coord.Make(1, 3)}})
+		}
+	})
+
 	t.Run("counts entire vertical word", func(t *testing.T) {
 		b := setupBoard()
 
@@ -222,9 +261,28 @@ func TestScoreWords(t *testing.T) {
 		}
 	})
 
+	t.Run("scores designated blanks as zero points", func(t *testing.T) {
+		b := setupBoard()
+
+		score, words, err := ScoreWords(play.Tiles{
+			{tile.Make('D', 2), coord.Make(2, 1)},
+			{tile.Make('O', 1), coord.Make(3, 1)},
+			{tile.MakeBlank().Designate("G"), coord.Make(4, 1)},
+		}, b, dictionary)
+
+		if err != nil {
+			t.Errorf("Expected success but got error %v", err)
+		} else {
+			if actual, expected := score, 3; actual != expected {
+				t.Errorf("Expected a total score of %d but got %d", expected, actual)
+			}
+			expectFormedWords(t, words, play.Word{"DOG", 3, coord.Range{coord.Make(2, 1), coord.Make(4, 1)}})
+		}
+	})
+
 	t.Run("counts entire vertical word connected to existing tile", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(1, 4)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(1, 4)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(2, 4)},
@@ -243,9 +301,9 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("counts hooked words connected to the main word", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(5, 4)).Tile = &tile.Tile{Letter: 'D', Points: 2}
-		b.Position(coord.Make(6, 4)).Tile = &tile.Tile{Letter: 'O', Points: 1}
-		b.Position(coord.Make(7, 4)).Tile = &tile.Tile{Letter: 'G', Points: 2}
+		b.Position(coord.Make(5, 4)).Tile = &tile.Tile{Letter: "D", Points: 2}
+		b.Position(coord.Make(6, 4)).Tile = &tile.Tile{Letter: "O", Points: 1}
+		b.Position(coord.Make(7, 4)).Tile = &tile.Tile{Letter: "G", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('S', 2), coord.Make(8, 4)},
@@ -266,9 +324,9 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("does not count connected words which were unmodified by the play", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(5, 4)).Tile = &tile.Tile{Letter: 'D', Points: 2}
-		b.Position(coord.Make(6, 4)).Tile = &tile.Tile{Letter: 'O', Points: 1}
-		b.Position(coord.Make(7, 4)).Tile = &tile.Tile{Letter: 'G', Points: 2}
+		b.Position(coord.Make(5, 4)).Tile = &tile.Tile{Letter: "D", Points: 2}
+		b.Position(coord.Make(6, 4)).Tile = &tile.Tile{Letter: "O", Points: 1}
+		b.Position(coord.Make(7, 4)).Tile = &tile.Tile{Letter: "G", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('G', 2), coord.Make(6, 3)},
@@ -287,7 +345,7 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("awards double-letter score under a newly placed tile", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(2, 4)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(2, 4)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(2, 5)},
@@ -306,9 +364,9 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("awards double-letter score for each word formed", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(2, 4)).Tile = &tile.Tile{Letter: 'D', Points: 2}
-		b.Position(coord.Make(3, 6)).Tile = &tile.Tile{Letter: 'O', Points: 1}
-		b.Position(coord.Make(4, 6)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(2, 4)).Tile = &tile.Tile{Letter: "D", Points: 2}
+		b.Position(coord.Make(3, 6)).Tile = &tile.Tile{Letter: "O", Points: 1}
+		b.Position(coord.Make(4, 6)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(2, 5)},
@@ -329,7 +387,7 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("does not award double-letter score under an existing tile", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(2, 8)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(2, 8)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(2, 9)},
@@ -348,7 +406,7 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("awards triple-letter score under a newly placed tile", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(1, 3)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(1, 3)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(1, 4)},
@@ -367,9 +425,9 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("awards triple-letter score for each word formed", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(1, 3)).Tile = &tile.Tile{Letter: 'D', Points: 2}
-		b.Position(coord.Make(2, 5)).Tile = &tile.Tile{Letter: 'O', Points: 1}
-		b.Position(coord.Make(3, 5)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(1, 3)).Tile = &tile.Tile{Letter: "D", Points: 2}
+		b.Position(coord.Make(2, 5)).Tile = &tile.Tile{Letter: "O", Points: 1}
+		b.Position(coord.Make(3, 5)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(1, 4)},
@@ -390,7 +448,7 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("does not award triple-letter score under an existing tile", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(1, 9)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(1, 9)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(1, 10)},
@@ -409,7 +467,7 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("awards double-word score under a newly placed tile", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(3, 1)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(3, 1)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(3, 2)},
@@ -428,7 +486,7 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("awards double-word score for the start position", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(7, 5)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(7, 5)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(7, 6)},
@@ -447,9 +505,9 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("awards double-word score for each word formed", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(3, 1)).Tile = &tile.Tile{Letter: 'D', Points: 2}
-		b.Position(coord.Make(4, 3)).Tile = &tile.Tile{Letter: 'O', Points: 1}
-		b.Position(coord.Make(5, 3)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(3, 1)).Tile = &tile.Tile{Letter: "D", Points: 2}
+		b.Position(coord.Make(4, 3)).Tile = &tile.Tile{Letter: "O", Points: 1}
+		b.Position(coord.Make(5, 3)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(3, 2)},
@@ -470,7 +528,7 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("does not award double-word score under an existing tile", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(1, 1)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(1, 1)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(1, 2)},
@@ -489,8 +547,8 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("awards double-word score only for the word its under", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(1, 1)).Tile = &tile.Tile{Letter: 'D', Points: 2}
-		b.Position(coord.Make(1, 2)).Tile = &tile.Tile{Letter: 'O', Points: 1}
+		b.Position(coord.Make(1, 1)).Tile = &tile.Tile{Letter: "D", Points: 2}
+		b.Position(coord.Make(1, 2)).Tile = &tile.Tile{Letter: "O", Points: 1}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('G', 2), coord.Make(1, 3)},
@@ -512,7 +570,7 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("awards triple-word score under a newly placed tile", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(0, 12)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(0, 12)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(0, 13)},
@@ -531,9 +589,9 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("awards triple-word score for each word formed", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(0, 12)).Tile = &tile.Tile{Letter: 'D', Points: 2}
-		b.Position(coord.Make(1, 14)).Tile = &tile.Tile{Letter: 'O', Points: 1}
-		b.Position(coord.Make(2, 14)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(0, 12)).Tile = &tile.Tile{Letter: "D", Points: 2}
+		b.Position(coord.Make(1, 14)).Tile = &tile.Tile{Letter: "O", Points: 1}
+		b.Position(coord.Make(2, 14)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(0, 13)},
@@ -554,7 +612,7 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("does not award triple-word score under an existing tile", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(0, 0)).Tile = &tile.Tile{Letter: 'D', Points: 2}
+		b.Position(coord.Make(0, 0)).Tile = &tile.Tile{Letter: "D", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('O', 1), coord.Make(0, 1)},
@@ -573,8 +631,8 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("awards triple-word score only for the word its under", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(13, 6)).Tile = &tile.Tile{Letter: 'D', Points: 2}
-		b.Position(coord.Make(13, 8)).Tile = &tile.Tile{Letter: 'G', Points: 2}
+		b.Position(coord.Make(13, 6)).Tile = &tile.Tile{Letter: "D", Points: 2}
+		b.Position(coord.Make(13, 8)).Tile = &tile.Tile{Letter: "G", Points: 2}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('G', 2), coord.Make(12, 7)},
@@ -596,10 +654,10 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("awards an extra point bonus if a full rack's worth of tiles is played", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(2, 6)).Tile = &tile.Tile{Letter: 'P', Points: 3}
-		b.Position(coord.Make(2, 8)).Tile = &tile.Tile{Letter: 'A', Points: 1}
-		b.Position(coord.Make(3, 3)).Tile = &tile.Tile{Letter: 'E', Points: 1}
-		b.Position(coord.Make(4, 3)).Tile = &tile.Tile{Letter: 'L', Points: 1}
+		b.Position(coord.Make(2, 6)).Tile = &tile.Tile{Letter: "P", Points: 3}
+		b.Position(coord.Make(2, 8)).Tile = &tile.Tile{Letter: "A", Points: 1}
+		b.Position(coord.Make(3, 3)).Tile = &tile.Tile{Letter: "E", Points: 1}
+		b.Position(coord.Make(4, 3)).Tile = &tile.Tile{Letter: "L", Points: 1}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('E', 1), coord.Make(2, 3)},
@@ -625,7 +683,7 @@ func TestScoreWords(t *testing.T) {
 
 	t.Run("awards stacked word score bonuses", func(t *testing.T) {
 		b := setupBoard()
-		b.Position(coord.Make(0, 8)).Tile = &tile.Tile{Letter: 'S', Points: 1}
+		b.Position(coord.Make(0, 8)).Tile = &tile.Tile{Letter: "S", Points: 1}
 
 		score, words, err := ScoreWords(play.Tiles{
 			{tile.Make('E', 1), coord.Make(0, 0)},
@@ -650,4 +708,134 @@ func TestScoreWords(t *testing.T) {
 			expectFormedWords(t, words, play.Word{"ELEPHANTS", expectedWordScore, coord.Range{coord.Make(0, 0), coord.Make(0, 8)}})
 		}
 	})
+
+	t.Run("with a toroidal board", func(t *testing.T) {
+
+		setupBoard := func() *board.Board {
+			__, _, _, _, _, _ := board.AllPositionTypes()
+			b := board.WithLayout(board.Layout{
+				{__, __, __, __, __},
+				{__, __, __, __, __},
+				{__, __, __, __, __},
+				{__, __, __, __, __},
+				{__, __, __, __, __},
+			})
+			b.Topology = board.ToroidalTopology()
+			return &b
+		}
+
+		t.Run("counts words across the edge of the board", func(t *testing.T) {
+			b := setupBoard()
+			b.Position(coord.Make(2, 0)).Tile = &tile.Tile{Letter: "G", Points: 2}
+
+			score, words, err := ScoreWords(play.Tiles{
+				{tile.Make('D', 2), coord.Make(2, 3)},
+				{tile.Make('O', 1), coord.Make(2, 4)},
+			}, b, dictionary)
+
+			if err != nil {
+				t.Errorf("Expected success but got error %v", err)
+			} else {
+				if actual, expected := score, 5; actual != expected {
+					t.Errorf("Expected a total score of %d but got %d", expected, actual)
+				}
+				expectFormedWords(t, words, play.Word{"DOG", 5, coord.Range{coord.Make(2, 3), coord.Make(2, 5)}})
+			}
+		})
+
+		t.Run("doesn't let a word overlap itself when it wraps all the way around", func(t *testing.T) {
+			b := setupBoard()
+			b.Position(coord.Make(0, 2)).Tile = &tile.Tile{Letter: "A", Points: 1}
+			b.Position(coord.Make(1, 2)).Tile = &tile.Tile{Letter: "B", Points: 1}
+			b.Position(coord.Make(3, 2)).Tile = &tile.Tile{Letter: "D", Points: 1}
+
+			score, words, err := ScoreWords(play.Tiles{
+				{tile.Make('C', 1), coord.Make(2, 2)},
+				{tile.Make('E', 1), coord.Make(4, 2)},
+			}, b, dictionary)
+
+			if err != nil {
+				t.Errorf("Expected success but got error %v", err)
+			} else {
+				if actual, expected := score, 5; actual != expected {
+					t.Errorf("Expected a total score of %d but got %d", expected, actual)
+				}
+				expectFormedWords(t, words, play.Word{"ABCDE", 5, coord.Range{coord.Make(0, 2), coord.Make(4, 2)}})
+			}
+		})
+	})
+}
+
+func TestScoreWordsWithBonus(t *testing.T) {
+	dictionary := func(word string) (valid bool) {
+		return true
+	}
+
+	t.Run("awards the specified bonus if a full rack's worth of tiles is played", func(t *testing.T) {
+		b := board.WithStandardLayout()
+
+		score, _, err := ScoreWordsWithBonus(tile.MaxRackTiles, AppMaxRackTilesBonus)(play.Tiles{
+			{tile.Make('R', 1), coord.Make(7, 1)},
+			{tile.Make('E', 1), coord.Make(7, 2)},
+			{tile.Make('T', 1), coord.Make(7, 3)},
+			{tile.Make('A', 1), coord.Make(7, 4)},
+			{tile.Make('I', 1), coord.Make(7, 5)},
+			{tile.Make('N', 1), coord.Make(7, 6)},
+			{tile.Make('S', 1), coord.Make(7, 7)},
+		}, &b, dictionary)
+
+		if err != nil {
+			t.Errorf("Expected success but got error %v", err)
+		} else if actual, expected := score, 2*(1+1+1+2*1+1+1+1)+AppMaxRackTilesBonus; actual != expected {
+			t.Errorf("Expected a total score of %d but got %d", expected, actual)
+		}
+	})
+
+	t.Run("awards the bonus for a full rack of the specified size", func(t *testing.T) {
+		b := board.WithStandardLayout()
+		placements := play.Tiles{
+			{tile.Make('A', 1), coord.Make(7, 7)},
+			{tile.Make('T', 1), coord.Make(7, 8)},
+		}
+
+		score, _, _ := ScoreWordsWithBonus(2, AppMaxRackTilesBonus)(placements, &b, dictionary)
+
+		if actual, expected := score, 4+AppMaxRackTilesBonus; actual != expected {
+			t.Errorf("Expected a total score of %d but got %d", expected, actual)
+		}
+	})
+
+	t.Run("awards no bonus for fewer tiles", func(t *testing.T) {
+		b := board.WithStandardLayout()
+
+		score, _, _ := ScoreWordsWithBonus(tile.MaxRackTiles, AppMaxRackTilesBonus)(play.Tiles{
+			{tile.Make('A', 1), coord.Make(7, 7)},
+			{tile.Make('T', 1), coord.Make(7, 8)},
+		}, &b, dictionary)
+
+		if actual, expected := score, 4; actual != expected {
+			t.Errorf("Expected a total score of %d but got %d", expected, actual)
+		}
+	})
+}
+
+func TestScoreWordsWithSchedule(t *testing.T) {
+	dictionary := func(word string) (valid bool) {
+		return true
+	}
+
+	t.Run("awards bonus points according to the schedule", func(t *testing.T) {
+		b := board.WithStandardLayout()
+
+		score, _, err := ScoreWordsWithSchedule(func(tilesPlayed int) int { return tilesPlayed * 100 })(play.Tiles{
+			{tile.Make('A', 1), coord.Make(7, 7)},
+			{tile.Make('T', 1), coord.Make(7, 8)},
+		}, &b, dictionary)
+
+		if err != nil {
+			t.Errorf("Expected success but got error %v", err)
+		} else if actual, expected := score, 4+200; actual != expected {
+			t.Errorf("Expected a total score of %d but got %d", expected, actual)
+		}
+	})
 }
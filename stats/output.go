@@ -0,0 +1,79 @@
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// WriteJSON writes this report to the specified writer as indented JSON.
+func (r Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteText writes this report to the specified writer as a human readable
+// table, with a column for each player. Players are labelled with the
+// specified names where given, or by their seat numbers otherwise.
+func (r Report) WriteText(w io.Writer, playerNames ...string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	row := func(label string, value func(p PlayerStats) string) {
+		fmt.Fprintf(tw, "%s\t", label)
+		for _, p := range r.Players {
+			fmt.Fprintf(tw, "%s\t", value(p))
+		}
+		fmt.Fprintln(tw)
+	}
+
+	row("", func(p PlayerStats) string { return p.label(playerNames) })
+	row("Final score", func(p PlayerStats) string { return fmt.Sprint(p.FinalScore) })
+	row("Turns", func(p PlayerStats) string { return fmt.Sprint(p.Turns) })
+	row("Plays", func(p PlayerStats) string { return fmt.Sprint(p.Plays) })
+	row("Bingos", func(p PlayerStats) string { return fmt.Sprint(p.Bingos) })
+	row("Points per turn", func(p PlayerStats) string { return fmt.Sprintf("%.1f", p.PointsPerTurn) })
+	row("Highest play", func(p PlayerStats) string {
+		if p.HighestPlay == nil {
+			return "-"
+		}
+		return fmt.Sprint(p.HighestPlay.Score)
+	})
+	row("Premium squares used", func(p PlayerStats) string { return fmt.Sprint(p.PremiumSquaresUsed) })
+	row("Blanks played", func(p PlayerStats) string { return fmt.Sprint(p.BlanksPlayed) })
+	row("Exchanges", func(p PlayerStats) string { return fmt.Sprint(p.Exchanges) })
+	row("Passes", func(p PlayerStats) string { return fmt.Sprint(p.Passes) })
+	row("Phonies played", func(p PlayerStats) string { return fmt.Sprint(p.PhoniesPlayed) })
+	row("Phonies caught", func(p PlayerStats) string { return fmt.Sprint(p.PhoniesCaught) })
+	row("Challenges failed", func(p PlayerStats) string { return fmt.Sprint(p.ChallengesFailed) })
+	row("Tiles drawn", func(p PlayerStats) string { return fmt.Sprint(p.TilesDrawn.Total()) })
+	row("  blanks", func(p PlayerStats) string { return fmt.Sprint(p.TilesDrawn.Blanks) })
+	row("  high value", func(p PlayerStats) string { return fmt.Sprint(p.TilesDrawn.HighValue) })
+	row("  medium value", func(p PlayerStats) string { return fmt.Sprint(p.TilesDrawn.MediumValue) })
+	row("  low value", func(p PlayerStats) string { return fmt.Sprint(p.TilesDrawn.LowValue) })
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d turns", r.Turns)
+	if err == nil && r.HighestPlay != nil {
+		_, err = fmt.Fprintf(w, ", highest play %s for %d by %s",
+			strings.Join(r.HighestPlay.Words, "/"),
+			r.HighestPlay.Score,
+			r.Players[r.HighestPlay.SeatIndex].label(playerNames))
+	}
+	if err == nil {
+		_, err = fmt.Fprintln(w)
+	}
+	return err
+}
+
+func (p PlayerStats) label(playerNames []string) string {
+	if p.SeatIndex < len(playerNames) {
+		return playerNames[p.SeatIndex]
+	}
+	return fmt.Sprintf("Seat %d", p.SeatIndex+1)
+}
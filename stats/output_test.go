@@ -0,0 +1,53 @@
+package stats
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestReport(t *testing.T) {
+
+	t.Run(".WriteJSON()", func(t *testing.T) {
+
+		t.Run("writes a report which can be read back", func(t *testing.T) {
+			r := Compute(setupGame())
+
+			var buf bytes.Buffer
+			if err := r.WriteJSON(&buf); err != nil {
+				t.Fatalf("Expected success but got error %v", err)
+			}
+
+			var decoded Report
+			if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+				t.Fatalf("Expected valid JSON but got error %v", err)
+			}
+			if actual, expected := decoded.Players[0].Bingos, r.Players[0].Bingos; actual != expected {
+				t.Errorf("Expected %d bingos but got %d", expected, actual)
+			}
+			if actual, expected := decoded.HighestPlay.Score, r.HighestPlay.Score; actual != expected {
+				t.Errorf("Expected highest play score %d but got %d", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".WriteText()", func(t *testing.T) {
+
+		t.Run("writes a table labelled with player names", func(t *testing.T) {
+			r := Compute(setupGame())
+
+			var buf bytes.Buffer
+			if err := r.WriteText(&buf, "Alice"); err != nil {
+				t.Fatalf("Expected success but got error %v", err)
+			}
+			text := buf.String()
+
+			for _, expected := range []string{"Alice", "Seat 2", "Bingos", "highest play RETAINS for 70 by Alice"} {
+				if !strings.Contains(text, expected) {
+					t.Errorf("Expected text to contain '%s' but got:\n%s", expected, text)
+				}
+			}
+		})
+	})
+}
@@ -0,0 +1,140 @@
+package stats
+
+import (
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/game"
+	"github.com/mandykoh/scrubble/history"
+)
+
+// Play summarises a single play made during a game.
+type Play struct {
+	SeatIndex int      `json:"seatIndex"`
+	Turn      int      `json:"turn"`
+	Score     int      `json:"score"`
+	Words     []string `json:"words"`
+}
+
+// PlayerStats represents the statistics for a single player over a game.
+//
+// Plays which were withdrawn after a successful challenge (phonies) count as
+// turns, but otherwise don't contribute to a player's play statistics.
+type PlayerStats struct {
+	SeatIndex          int         `json:"seatIndex"`
+	FinalScore         int         `json:"finalScore"`
+	Turns              int         `json:"turns"`
+	Plays              int         `json:"plays"`
+	Bingos             int         `json:"bingos"`
+	PointsPerTurn      float64     `json:"pointsPerTurn"`
+	HighestPlay        *Play       `json:"highestPlay,omitempty"`
+	PremiumSquaresUsed int         `json:"premiumSquaresUsed"`
+	BlanksPlayed       int         `json:"blanksPlayed"`
+	Exchanges          int         `json:"exchanges"`
+	Passes             int         `json:"passes"`
+	PhoniesPlayed      int         `json:"phoniesPlayed"`
+	PhoniesCaught      int         `json:"phoniesCaught"`
+	ChallengesFailed   int         `json:"challengesFailed"`
+	TilesDrawn         TileQuality `json:"tilesDrawn"`
+}
+
+// Report represents the statistics for a completed (or in progress) game.
+type Report struct {
+	Turns       int           `json:"turns"`
+	HighestPlay *Play         `json:"highestPlay,omitempty"`
+	Players     []PlayerStats `json:"players"`
+}
+
+// Compute returns the statistics for the specified game, as determined from
+// its history and final state.
+//
+// Bingos are plays which use a full rack of tiles. Premium squares are any
+// board positions other than normal and start positions. Tiles drawn include
+// only those drawn after each turn (not the players' opening racks).
+func Compute(g *game.Game) Report {
+	r := Report{
+		Players: make([]PlayerStats, len(g.Seats)),
+	}
+
+	for i, s := range g.Seats {
+		r.Players[i].SeatIndex = i
+		r.Players[i].FinalScore = s.Score
+	}
+
+	points := make([]int, len(g.Seats))
+
+	for i, e := range g.History {
+		if e.SeatIndex < 0 || e.SeatIndex >= len(r.Players) {
+			continue
+		}
+		p := &r.Players[e.SeatIndex]
+
+		switch e.Type {
+
+		case history.PlayEntryType:
+			r.Turns++
+			p.Turns++
+
+			if g.History.Withdrawn(i) {
+				p.PhoniesPlayed++
+				continue
+			}
+
+			p.Plays++
+			points[e.SeatIndex] += e.Score
+			p.TilesDrawn.Add(e.TilesDrawn...)
+
+			if len(e.TilesPlayed) >= g.Rules.RackSize() {
+				p.Bingos++
+			}
+
+			for _, t := range e.TilesPlayed {
+				if t.Tile.Blank {
+					p.BlanksPlayed++
+				}
+				if isPremium(g.Board.Position(t.Coord)) {
+					p.PremiumSquaresUsed++
+				}
+			}
+
+			play := &Play{SeatIndex: e.SeatIndex, Turn: r.Turns, Score: e.Score}
+			for _, w := range e.WordsFormed {
+				play.Words = append(play.Words, w.Word)
+			}
+			if p.HighestPlay == nil || play.Score > p.HighestPlay.Score {
+				p.HighestPlay = play
+			}
+			if r.HighestPlay == nil || play.Score > r.HighestPlay.Score {
+				r.HighestPlay = play
+			}
+
+		case history.ExchangeTilesEntryType:
+			r.Turns++
+			p.Turns++
+			p.Exchanges++
+			p.TilesDrawn.Add(e.TilesDrawn...)
+
+		case history.PassEntryType:
+			r.Turns++
+			p.Turns++
+			p.Passes++
+
+		case history.ChallengeSuccessEntryType:
+			p.PhoniesCaught++
+
+		case history.ChallengeFailEntryType:
+			p.ChallengesFailed++
+		}
+	}
+
+	for i := range r.Players {
+		if p := &r.Players[i]; p.Turns > 0 {
+			p.PointsPerTurn = float64(points[i]) / float64(p.Turns)
+		}
+	}
+
+	return r
+}
+
+func isPremium(pos *board.Position) bool {
+	__, st, _, _, _, _ := board.AllPositionTypes()
+	return pos != nil && pos.Type != __ && pos.Type != st && !pos.IsBlocked()
+}
@@ -0,0 +1,156 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/mandykoh/scrubble/board"
+	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/game"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/seat"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func setupGame() *game.Game {
+	g := &game.Game{
+		Board: board.WithStandardLayout(),
+		Seats: []seat.Seat{{Score: 120}, {Score: 40}},
+	}
+
+	bingo := play.Tiles{
+		{tile.Make('R', 1), coord.Make(7, 1)},
+		{tile.Make('E', 1), coord.Make(7, 2)},
+		{tile.Make('T', 1), coord.Make(7, 3)},
+		{tile.Make('A', 1), coord.Make(7, 4)},
+		{tile.MakeBlank().Designate("I"), coord.Make(7, 5)},
+		{tile.Make('N', 1), coord.Make(7, 6)},
+		{tile.Make('S', 1), coord.Make(7, 7)},
+	}
+
+	g.History.AppendPlay(0, 70, bingo.Tiles(), bingo, []tile.Tile{tile.Make('Q', 10), tile.MakeBlank(), tile.Make('K', 5)}, []play.Word{
+		{Word: "RETAINS", Score: 70},
+	})
+	g.History.AppendPlay(1, 30, nil, play.Tiles{
+		{tile.Make('X', 8), coord.Make(6, 1)},
+		{tile.Make('I', 1), coord.Make(6, 2)},
+	}, []tile.Tile{tile.Make('E', 1)}, []play.Word{{Word: "XI", Score: 30}})
+	g.History.AppendChallengeSuccess(0)
+	g.History.AppendExchange(0, nil, []tile.Tile{tile.Make('A', 1), tile.Make('Z', 10)})
+	g.History.AppendPlay(1, 40, nil, play.Tiles{
+		{tile.Make('Z', 10), coord.Make(8, 1)},
+		{tile.Make('A', 1), coord.Make(8, 2)},
+	}, nil, []play.Word{{Word: "ZA", Score: 40}})
+	g.History.AppendChallengeFail(0)
+	g.History.AppendPass(0)
+
+	return g
+}
+
+func TestCompute(t *testing.T) {
+	r := Compute(setupGame())
+	p0, p1 := r.Players[0], r.Players[1]
+
+	t.Run("counts turns", func(t *testing.T) {
+		if actual, expected := r.Turns, 5; actual != expected {
+			t.Errorf("Expected %d turns but got %d", expected, actual)
+		}
+		if actual, expected := p0.Turns, 3; actual != expected {
+			t.Errorf("Expected %d turns for first player but got %d", expected, actual)
+		}
+		if actual, expected := p1.Turns, 2; actual != expected {
+			t.Errorf("Expected %d turns for second player but got %d", expected, actual)
+		}
+	})
+
+	t.Run("records final scores", func(t *testing.T) {
+		if actual, expected := p0.FinalScore, 120; actual != expected {
+			t.Errorf("Expected final score %d but got %d", expected, actual)
+		}
+	})
+
+	t.Run("counts bingos, blanks, and premium squares of plays", func(t *testing.T) {
+		if actual, expected := p0.Bingos, 1; actual != expected {
+			t.Errorf("Expected %d bingos but got %d", expected, actual)
+		}
+		if actual, expected := p0.BlanksPlayed, 1; actual != expected {
+			t.Errorf("Expected %d blanks played but got %d", expected, actual)
+		}
+		if actual, expected := p0.PremiumSquaresUsed, 1; actual != expected {
+			t.Errorf("Expected %d premium squares used but got %d", expected, actual)
+		}
+		if actual, expected := p1.PremiumSquaresUsed, 1; actual != expected {
+			t.Errorf("Expected %d premium squares used but got %d", expected, actual)
+		}
+	})
+
+	t.Run("counts bingos according to the rack size of the rules", func(t *testing.T) {
+		g := setupGame()
+		g.Rules = g.Rules.WithRackSize(8)
+
+		if actual, expected := Compute(g).Players[0].Bingos, 0; actual != expected {
+			t.Errorf("Expected %d bingos with eight tile racks but got %d", expected, actual)
+		}
+	})
+
+	t.Run("counts exchanges, passes, and challenges", func(t *testing.T) {
+		if actual, expected := p0.Exchanges, 1; actual != expected {
+			t.Errorf("Expected %d exchanges but got %d", expected, actual)
+		}
+		if actual, expected := p0.Passes, 1; actual != expected {
+			t.Errorf("Expected %d passes but got %d", expected, actual)
+		}
+		if actual, expected := p0.PhoniesCaught, 1; actual != expected {
+			t.Errorf("Expected %d phonies caught but got %d", expected, actual)
+		}
+		if actual, expected := p0.ChallengesFailed, 1; actual != expected {
+			t.Errorf("Expected %d failed challenges but got %d", expected, actual)
+		}
+		if actual, expected := p1.PhoniesPlayed, 1; actual != expected {
+			t.Errorf("Expected %d phonies played but got %d", expected, actual)
+		}
+	})
+
+	t.Run("excludes withdrawn plays from play statistics", func(t *testing.T) {
+		if actual, expected := p1.Plays, 1; actual != expected {
+			t.Errorf("Expected %d plays but got %d", expected, actual)
+		}
+		if actual, expected := p1.PointsPerTurn, 20.0; actual != expected {
+			t.Errorf("Expected %v points per turn but got %v", expected, actual)
+		}
+		if actual, expected := p1.TilesDrawn.Total(), 0; actual != expected {
+			t.Errorf("Expected %d tiles drawn but got %d", expected, actual)
+		}
+	})
+
+	t.Run("finds the highest scoring plays", func(t *testing.T) {
+		if r.HighestPlay == nil {
+			t.Fatalf("Expected a highest play")
+		}
+		if actual, expected := r.HighestPlay.Words[0], "RETAINS"; actual != expected {
+			t.Errorf("Expected highest play '%s' but got '%s'", expected, actual)
+		}
+		if actual, expected := p1.HighestPlay.Score, 40; actual != expected {
+			t.Errorf("Expected highest play score of %d but got %d", expected, actual)
+		}
+		if actual, expected := p1.HighestPlay.Turn, 4; actual != expected {
+			t.Errorf("Expected highest play on turn %d but got %d", expected, actual)
+		}
+	})
+
+	t.Run("counts tiles drawn by quality", func(t *testing.T) {
+		if actual, expected := p0.TilesDrawn, (TileQuality{Blanks: 1, HighValue: 2, MediumValue: 1, LowValue: 1}); actual != expected {
+			t.Errorf("Expected tiles drawn %+v but got %+v", expected, actual)
+		}
+	})
+
+	t.Run("handles games without history", func(t *testing.T) {
+		r := Compute(&game.Game{Seats: []seat.Seat{{}}})
+
+		if actual, expected := r.Players[0].PointsPerTurn, 0.0; actual != expected {
+			t.Errorf("Expected %v points per turn but got %v", expected, actual)
+		}
+		if r.HighestPlay != nil {
+			t.Errorf("Expected no highest play but got %v", r.HighestPlay)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package stats
+
+import "github.com/mandykoh/scrubble/tile"
+
+// HighValuePoints is the minimum number of points for a tile to be counted as
+// a high value tile (eg J, Q, X, and Z in English).
+const HighValuePoints = 8
+
+// MediumValuePoints is the minimum number of points for a tile to be counted as
+// a medium value tile (eg F, H, K, V, W, and Y in English).
+const MediumValuePoints = 4
+
+// TileQuality represents counts of tiles by their quality, as determined by
+// their point values.
+type TileQuality struct {
+	Blanks      int `json:"blanks"`
+	HighValue   int `json:"highValue"`
+	MediumValue int `json:"mediumValue"`
+	LowValue    int `json:"lowValue"`
+}
+
+// Add counts the specified tiles towards this tile quality.
+func (q *TileQuality) Add(tiles ...tile.Tile) {
+	for _, t := range tiles {
+		switch {
+		case t.Blank:
+			q.Blanks++
+		case t.Points >= HighValuePoints:
+			q.HighValue++
+		case t.Points >= MediumValuePoints:
+			q.MediumValue++
+		default:
+			q.LowValue++
+		}
+	}
+}
+
+// Total returns the total number of tiles counted.
+func (q TileQuality) Total() int {
+	return q.Blanks + q.HighValue + q.MediumValue + q.LowValue
+}
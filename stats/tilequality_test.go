@@ -0,0 +1,25 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func TestTileQuality(t *testing.T) {
+
+	t.Run(".Add()", func(t *testing.T) {
+
+		t.Run("counts tiles by point value", func(t *testing.T) {
+			var q TileQuality
+			q.Add(tile.MakeBlank(), tile.Make('Q', 10), tile.Make('K', 5), tile.Make('E', 1), tile.Make('D', 2))
+
+			if actual, expected := q, (TileQuality{Blanks: 1, HighValue: 1, MediumValue: 1, LowValue: 2}); actual != expected {
+				t.Errorf("Expected %+v but got %+v", expected, actual)
+			}
+			if actual, expected := q.Total(), 5; actual != expected {
+				t.Errorf("Expected a total of %d but got %d", expected, actual)
+			}
+		})
+	})
+}
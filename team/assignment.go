@@ -0,0 +1,80 @@
+package team
+
+import (
+	"sort"
+
+	"github.com/mandykoh/scrubble/seat"
+)
+
+// Assignment represents the teams of a game, as the index of the team for each
+// seat (by seat index). Teams are numbered from zero.
+type Assignment []int
+
+// Alternating returns an Assignment of the specified number of seats to the
+// given number of teams, such that teammates alternate turns with members of
+// the other teams. For example, four seats in two teams are assigned as seats
+// 0 and 2 to team 0, and seats 1 and 3 to team 1.
+func Alternating(seats, teams int) Assignment {
+	a := make(Assignment, seats)
+	for i := range a {
+		a[i] = i % teams
+	}
+	return a
+}
+
+// Count returns the number of teams.
+func (a Assignment) Count() (teams int) {
+	for _, t := range a {
+		if t+1 > teams {
+			teams = t + 1
+		}
+	}
+	return
+}
+
+// Members returns the indices of the seats in the specified team, in order.
+func (a Assignment) Members(team int) (seatIndices []int) {
+	for i, t := range a {
+		if t == team {
+			seatIndices = append(seatIndices, i)
+		}
+	}
+	return
+}
+
+// Partners returns whether the seats at the specified indices are in the same
+// team. A seat is considered to be its own partner.
+func (a Assignment) Partners(seatIndex, otherSeatIndex int) bool {
+	return a[seatIndex] == a[otherSeatIndex]
+}
+
+// Standings returns the standing of each team, ordered from highest to lowest
+// total score (and then by team).
+func (a Assignment) Standings(seats []seat.Seat) []Standing {
+	totals := a.Totals(seats)
+
+	standings := make([]Standing, len(totals))
+	for team, total := range totals {
+		standings[team] = Standing{
+			Team:  team,
+			Score: total,
+			Seats: a.Members(team),
+		}
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		return standings[i].Score > standings[j].Score
+	})
+
+	return standings
+}
+
+// Totals returns the total score of each team, being the sum of the scores of
+// its seats.
+func (a Assignment) Totals(seats []seat.Seat) []int {
+	totals := make([]int, a.Count())
+	for i, s := range seats {
+		totals[a[i]] += s.Score
+	}
+	return totals
+}
@@ -0,0 +1,85 @@
+package team
+
+import (
+	"testing"
+
+	"github.com/mandykoh/scrubble/seat"
+)
+
+func TestAlternating(t *testing.T) {
+
+	t.Run("assigns seats to teams in turn", func(t *testing.T) {
+		a := Alternating(6, 3)
+
+		for i, expected := range []int{0, 1, 2, 0, 1, 2} {
+			if actual := a[i]; actual != expected {
+				t.Errorf("Expected seat %d to be in team %d but was in %d", i, expected, actual)
+			}
+		}
+	})
+}
+
+func TestAssignment(t *testing.T) {
+	a := Alternating(4, 2)
+	seats := []seat.Seat{{Score: 100}, {Score: 120}, {Score: 50}, {Score: 20}}
+
+	t.Run(".Count()", func(t *testing.T) {
+
+		t.Run("returns the number of teams", func(t *testing.T) {
+			if actual, expected := a.Count(), 2; actual != expected {
+				t.Errorf("Expected %d teams but got %d", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".Members()", func(t *testing.T) {
+
+		t.Run("returns the seats of a team", func(t *testing.T) {
+			members := a.Members(1)
+
+			if len(members) != 2 || members[0] != 1 || members[1] != 3 {
+				t.Errorf("Expected seats 1 and 3 but got %v", members)
+			}
+		})
+	})
+
+	t.Run(".Partners()", func(t *testing.T) {
+
+		t.Run("returns whether seats are in the same team", func(t *testing.T) {
+			if !a.Partners(0, 2) {
+				t.Errorf("Expected seats 0 and 2 to be partners")
+			}
+			if a.Partners(0, 1) {
+				t.Errorf("Expected seats 0 and 1 not to be partners")
+			}
+		})
+	})
+
+	t.Run(".Standings()", func(t *testing.T) {
+
+		t.Run("orders teams by total score", func(t *testing.T) {
+			standings := a.Standings(seats)
+
+			if actual, expected := standings[0], (Standing{Team: 0, Score: 150}); actual.Team != expected.Team || actual.Score != expected.Score {
+				t.Errorf("Expected first standing %+v but got %+v", expected, actual)
+			}
+			if actual, expected := standings[1], (Standing{Team: 1, Score: 140}); actual.Team != expected.Team || actual.Score != expected.Score {
+				t.Errorf("Expected second standing %+v but got %+v", expected, actual)
+			}
+			if actual, expected := len(standings[1].Seats), 2; actual != expected {
+				t.Errorf("Expected %d seats in team but got %d", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".Totals()", func(t *testing.T) {
+
+		t.Run("sums the scores of each team's seats", func(t *testing.T) {
+			totals := a.Totals(seats)
+
+			if totals[0] != 150 || totals[1] != 140 {
+				t.Errorf("Expected totals [150 140] but got %v", totals)
+			}
+		})
+	})
+}
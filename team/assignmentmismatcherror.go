@@ -0,0 +1,14 @@
+package team
+
+import "fmt"
+
+// AssignmentMismatchError indicates that a team assignment didn't assign
+// exactly the seats of the game to teams.
+type AssignmentMismatchError struct {
+	Seats    int
+	Assigned int
+}
+
+func (e AssignmentMismatchError) Error() string {
+	return fmt.Sprintf("%#v", e)
+}
@@ -0,0 +1,125 @@
+package team
+
+import (
+	"math/rand"
+
+	"github.com/mandykoh/scrubble/game"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// Game represents a game played in teams. Partners alternate turns (according
+// to the seating order and assignment) and their scores are totalled for each
+// team. Operations not overridden by Game are those of the embedded game.Game.
+//
+// If SharedRacks is true, the partners in each team share a single rack: only
+// one rack of tiles is drawn for each team, and each partner's rack is updated
+// after every move to match.
+type Game struct {
+	*game.Game
+	Teams       Assignment
+	SharedRacks bool
+}
+
+// New returns a team Game for the specified game and team assignment. The
+// game's rules are updated to use the team EndGameScorer.
+func New(g *game.Game, teams Assignment, sharedRacks bool) *Game {
+	g.Rules = g.Rules.WithEndGameScorer(EndGameScorer(teams, sharedRacks))
+
+	return &Game{
+		Game:        g,
+		Teams:       teams,
+		SharedRacks: sharedRacks,
+	}
+}
+
+// Challenge challenges the last turn's play as with game.Game.Challenge,
+// sharing the challenged player's restored rack with their partners if the
+// challenge succeeds.
+func (g *Game) Challenge(challengerSeatIndex int, r *rand.Rand) (success bool, err error) {
+	challengedSeatIndex := -1
+	if len(g.History) > 0 {
+		challengedSeatIndex = g.History.Last().SeatIndex
+	}
+
+	success, err = g.Game.Challenge(challengerSeatIndex, r)
+	if success {
+		g.shareRack(challengedSeatIndex)
+	}
+	return
+}
+
+// ExchangeTiles exchanges tiles as with game.Game.ExchangeTiles, sharing the
+// resulting rack with the player's partners.
+func (g *Game) ExchangeTiles(tiles []tile.Tile, r *rand.Rand) error {
+	seatIndex := g.CurrentSeatIndex
+
+	err := g.Game.ExchangeTiles(tiles, r)
+	if err == nil {
+		g.shareRack(seatIndex)
+	}
+	return err
+}
+
+// Play plays tiles as with game.Game.Play, sharing the resulting rack with the
+// player's partners.
+func (g *Game) Play(placements play.Tiles) (playedWords []play.Word, err error) {
+	seatIndex := g.CurrentSeatIndex
+
+	playedWords, err = g.Game.Play(placements)
+	if err == nil {
+		g.shareRack(seatIndex)
+	}
+	return
+}
+
+// Standings returns the current standing of each team, ordered from highest to
+// lowest total score.
+func (g *Game) Standings() []Standing {
+	return g.Teams.Standings(g.Seats)
+}
+
+// Start begins the game as with game.Game.Start. If racks are shared, the
+// tiles drawn by all but the first member of each team are returned to the bag
+// and replaced with a copy of the first member's rack.
+//
+// If the team assignment doesn't cover exactly the game's seats,
+// AssignmentMismatchError is returned.
+func (g *Game) Start(r *rand.Rand) error {
+	if len(g.Teams) != len(g.Seats) {
+		return AssignmentMismatchError{Seats: len(g.Seats), Assigned: len(g.Teams)}
+	}
+
+	err := g.Game.Start(r)
+	if err != nil || !g.SharedRacks {
+		return err
+	}
+
+	for team := 0; team < g.Teams.Count(); team++ {
+		members := g.Teams.Members(team)
+		for _, i := range members[1:] {
+			g.Bag = append(g.Bag, g.Seats[i].Rack...)
+		}
+		g.shareRack(members[0])
+	}
+	g.Bag.Shuffle(r)
+
+	return nil
+}
+
+// Totals returns the current total score of each team.
+func (g *Game) Totals() []int {
+	return g.Teams.Totals(g.Seats)
+}
+
+func (g *Game) shareRack(seatIndex int) {
+	if !g.SharedRacks || seatIndex < 0 {
+		return
+	}
+
+	for i := range g.Seats {
+		if i != seatIndex && g.Teams.Partners(i, seatIndex) {
+			g.Seats[i].Rack = append(tile.Rack{}, g.Seats[seatIndex].Rack...)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package team
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/game"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func TestGame(t *testing.T) {
+
+	setupGame := func(players int, sharedRacks bool) *Game {
+		g := game.NewWithDefaults()
+		for i := 0; i < players; i++ {
+			g.AddPlayer()
+		}
+		return New(g, Alternating(4, 2), sharedRacks)
+	}
+
+	expectSharedRacks := func(t *testing.T, g *Game) {
+		t.Helper()
+
+		for _, partners := range [][]int{{0, 2}, {1, 3}} {
+			a, b := g.Seats[partners[0]].Rack, g.Seats[partners[1]].Rack
+			if len(a) != len(b) {
+				t.Fatalf("Expected seats %v to share a rack but got %v and %v", partners, a, b)
+			}
+			for i := range a {
+				if a[i] != b[i] {
+					t.Fatalf("Expected seats %v to share a rack but got %v and %v", partners, a, b)
+				}
+			}
+		}
+	}
+
+	t.Run(".Start()", func(t *testing.T) {
+
+		t.Run("returns an error if the assignment doesn't match the seats", func(t *testing.T) {
+			g := setupGame(3, false)
+
+			err := g.Start(rand.New(rand.NewSource(1)))
+
+			if actual, expected := err, (AssignmentMismatchError{Seats: 3, Assigned: 4}); actual != expected {
+				t.Errorf("Expected error %v but got %v", expected, actual)
+			}
+		})
+
+		t.Run("draws one rack per team when racks are shared", func(t *testing.T) {
+			g := setupGame(4, true)
+
+			if err := g.Start(rand.New(rand.NewSource(1))); err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+
+			expectSharedRacks(t, g)
+
+			if actual, expected := len(g.Bag), 100-2*tile.MaxRackTiles; actual != expected {
+				t.Errorf("Expected %d tiles left in bag but found %d", expected, actual)
+			}
+		})
+
+		t.Run("draws a rack per seat when racks aren't shared", func(t *testing.T) {
+			g := setupGame(4, false)
+			g.Start(rand.New(rand.NewSource(1)))
+
+			if actual, expected := len(g.Bag), 100-4*tile.MaxRackTiles; actual != expected {
+				t.Errorf("Expected %d tiles left in bag but found %d", expected, actual)
+			}
+		})
+	})
+
+	t.Run("with shared racks", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		g := setupGame(4, true)
+		g.Start(rng)
+
+		t.Run(".Play() shares the player's new rack with their partner", func(t *testing.T) {
+			rack := g.CurrentSeat().Rack
+			placements := play.Tiles{
+				{rack[0], coord.Make(7, 7)},
+				{rack[1], coord.Make(7, 8)},
+			}
+
+			if _, err := g.Play(placements); err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+
+			expectSharedRacks(t, g)
+		})
+
+		t.Run(".Challenge() shares the challenged player's restored rack", func(t *testing.T) {
+			g.Rules = g.Rules.WithDictionary(func(string) bool { return false })
+
+			success, err := g.Challenge(g.CurrentSeatIndex, rng)
+			if err != nil || !success {
+				t.Fatalf("Expected successful challenge but got %v, %v", success, err)
+			}
+
+			expectSharedRacks(t, g)
+		})
+
+		t.Run(".ExchangeTiles() shares the player's new rack with their partner", func(t *testing.T) {
+			rack := g.CurrentSeat().Rack
+
+			if err := g.ExchangeTiles(rack[:3], rng); err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+
+			expectSharedRacks(t, g)
+		})
+	})
+
+	t.Run(".Totals()", func(t *testing.T) {
+
+		t.Run("returns the totals of each team", func(t *testing.T) {
+			g := setupGame(4, false)
+			for i := range g.Seats {
+				g.Seats[i].Score = (i + 1) * 10
+			}
+
+			totals := g.Totals()
+
+			if totals[0] != 40 || totals[1] != 60 {
+				t.Errorf("Expected totals [40 60] but got %v", totals)
+			}
+			if actual, expected := g.Standings()[0].Team, 1; actual != expected {
+				t.Errorf("Expected team %d to lead but got %d", expected, actual)
+			}
+		})
+	})
+}
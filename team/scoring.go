@@ -0,0 +1,65 @@
+package team
+
+import (
+	"github.com/mandykoh/scrubble/history"
+	"github.com/mandykoh/scrubble/scoring"
+	"github.com/mandykoh/scrubble/seat"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+// EndGameScorer returns a scoring.EndGameScorer for games played in teams with
+// the specified assignment. This works like scoring.ScoreEndGame, except that
+// the bonus for playing out is only awarded from the racks of opponents, and
+// never from a partner's rack.
+//
+// If sharedRacks is true, partners are assumed to share a single rack (as
+// with Game), so each team's rack is only counted once: for the bonus from
+// opponents, and for the penalty when the game ends without anyone playing
+// out (which is then taken from just one member of each team).
+func EndGameScorer(a Assignment, sharedRacks bool) scoring.EndGameScorer {
+	return func(lastPlay *history.Entry, seats []seat.Seat) (finalScores []int) {
+		finalScores = make([]int, len(seats))
+
+		counted := func(i int) bool {
+			return !sharedRacks || i == teamRackSeatIndex(a, a[i], lastPlay.SeatIndex)
+		}
+
+		if lastPlay.Type == history.PlayEntryType {
+			playOutBonus := 0
+			for i, s := range seats {
+				if a.Partners(i, lastPlay.SeatIndex) || !counted(i) {
+					continue
+				}
+				playOutBonus += rackPoints(s.Rack)
+			}
+			finalScores[lastPlay.SeatIndex] = playOutBonus * 2
+
+		} else {
+			for i, s := range seats {
+				if counted(i) {
+					finalScores[i] = -rackPoints(s.Rack)
+				}
+			}
+		}
+
+		return
+	}
+}
+
+func rackPoints(r tile.Rack) (points int) {
+	for _, t := range r {
+		points += t.Points
+	}
+	return
+}
+
+// teamRackSeatIndex returns the index of the seat holding the up to date rack
+// for a team which shares a rack. This is the seat which last moved if it is in
+// the team (since racks are only shared after each move), or otherwise the
+// team's first seat.
+func teamRackSeatIndex(a Assignment, team int, lastSeatIndex int) int {
+	if a[lastSeatIndex] == team {
+		return lastSeatIndex
+	}
+	return a.Members(team)[0]
+}
@@ -0,0 +1,74 @@
+package team
+
+import (
+	"testing"
+
+	"github.com/mandykoh/scrubble/history"
+	"github.com/mandykoh/scrubble/seat"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func TestEndGameScorer(t *testing.T) {
+	a := Alternating(4, 2)
+
+	t.Run("with separate racks", func(t *testing.T) {
+		scorer := EndGameScorer(a, false)
+		seats := []seat.Seat{
+			{Rack: tile.Rack{}},
+			{Rack: tile.Rack{tile.Make('C', 4)}},
+			{Rack: tile.Rack{tile.Make('D', 5)}},
+			{Rack: tile.Rack{tile.Make('E', 6)}},
+		}
+
+		t.Run("awards the play out bonus from opponents' racks only", func(t *testing.T) {
+			finalScores := scorer(&history.Entry{SeatIndex: 0, Type: history.PlayEntryType}, seats)
+
+			if actual, expected := finalScores[0], 20; actual != expected {
+				t.Errorf("Expected play out bonus of %d but got %d", expected, actual)
+			}
+			for i := 1; i < len(finalScores); i++ {
+				if finalScores[i] != 0 {
+					t.Errorf("Expected no score for seat %d but got %d", i, finalScores[i])
+				}
+			}
+		})
+
+		t.Run("penalises every seat when nobody played out", func(t *testing.T) {
+			finalScores := scorer(&history.Entry{SeatIndex: 3, Type: history.PassEntryType}, seats)
+
+			for i, expected := range []int{0, -4, -5, -6} {
+				if actual := finalScores[i]; actual != expected {
+					t.Errorf("Expected seat %d to score %d but got %d", i, expected, actual)
+				}
+			}
+		})
+	})
+
+	t.Run("with shared racks", func(t *testing.T) {
+		scorer := EndGameScorer(a, true)
+		seats := []seat.Seat{
+			{Rack: tile.Rack{}},
+			{Rack: tile.Rack{tile.Make('C', 4)}},
+			{Rack: tile.Rack{tile.Make('Q', 10)}},
+			{Rack: tile.Rack{tile.Make('C', 4)}},
+		}
+
+		t.Run("counts the opponents' shared rack once", func(t *testing.T) {
+			finalScores := scorer(&history.Entry{SeatIndex: 0, Type: history.PlayEntryType}, seats)
+
+			if actual, expected := finalScores[0], 8; actual != expected {
+				t.Errorf("Expected play out bonus of %d but got %d", expected, actual)
+			}
+		})
+
+		t.Run("penalises each team once using its most recent rack", func(t *testing.T) {
+			finalScores := scorer(&history.Entry{SeatIndex: 2, Type: history.ExchangeTilesEntryType}, seats)
+
+			for i, expected := range []int{0, -4, -10, 0} {
+				if actual := finalScores[i]; actual != expected {
+					t.Errorf("Expected seat %d to score %d but got %d", i, expected, actual)
+				}
+			}
+		})
+	})
+}
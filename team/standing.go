@@ -0,0 +1,8 @@
+package team
+
+// Standing represents a team's total score and the seats in the team.
+type Standing struct {
+	Team  int
+	Score int
+	Seats []int
+}
@@ -0,0 +1,80 @@
+package tile
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Alphabet represents the set of distinct letters which tiles can have. This
+// is used to tokenise words into the letters of individual tiles.
+type Alphabet []string
+
+// AlphabetOf returns the Alphabet of letters on the specified tiles, excluding
+// any blank tiles. Letters are sorted longest first, then in
+// lexical order.
+func AlphabetOf(tiles ...Tile) (a Alphabet) {
+	seen := make(map[string]bool)
+	for _, t := range tiles {
+		if !t.Blank && !seen[t.Letter] {
+			seen[t.Letter] = true
+			a = append(a, t.Letter)
+		}
+	}
+
+	sort.Slice(a, func(i, j int) bool {
+		lenI, lenJ := utf8.RuneCountInString(a[i]), utf8.RuneCountInString(a[j])
+		if lenI != lenJ {
+			return lenI > lenJ
+		}
+		return a[i] < a[j]
+	})
+
+	return
+}
+
+// Tokenise splits a word into the letters of the individual tiles needed to
+// spell it. Letters are matched greedily, so that the longest letter in the
+// alphabet which matches at each point in the word is used (eg "CHICO" with a
+// Spanish alphabet becomes "CH", "I", "C", "O"). Characters which don't match
+// any letter in the alphabet each become a letter by themselves.
+//
+// To override the greedy matching, a tile's letter can be enclosed in square
+// brackets, in which case it is always treated as a single tile regardless of
+// the alphabet (eg "[C]HICO" becomes "C", "H", "I", "C", "O").
+//
+// If a bracket is left unclosed or encloses nothing, MalformedLettersError is
+// returned.
+func (a Alphabet) Tokenise(word string) (letters []string, err error) {
+	for remaining := word; len(remaining) > 0; {
+
+		if remaining[0] == '[' {
+			end := strings.IndexByte(remaining, ']')
+			if end <= 1 {
+				return nil, MalformedLettersError{word}
+			}
+			letters = append(letters, remaining[1:end])
+			remaining = remaining[end+1:]
+			continue
+		}
+
+		letter := a.longestPrefixOf(remaining)
+		if letter == "" {
+			_, size := utf8.DecodeRuneInString(remaining)
+			letter = remaining[:size]
+		}
+		letters = append(letters, letter)
+		remaining = remaining[len(letter):]
+	}
+
+	return
+}
+
+func (a Alphabet) longestPrefixOf(s string) (longest string) {
+	for _, letter := range a {
+		if len(letter) > len(longest) && strings.HasPrefix(s, letter) {
+			longest = letter
+		}
+	}
+	return
+}
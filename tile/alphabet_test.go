@@ -0,0 +1,95 @@
+package tile
+
+import "testing"
+
+func TestAlphabetOf(t *testing.T) {
+
+	t.Run("returns distinct letters, longest first, excluding blanks", func(t *testing.T) {
+		alphabet := AlphabetOf(
+			Make('C', 3),
+			MakeLetters("CH", 5),
+			Make('A', 1),
+			MakeBlank(),
+			Make('C', 3),
+			MakeLetters("LL", 8),
+		)
+
+		expectedLetters := []string{"CH", "LL", "A", "C"}
+
+		if actual, expected := len(alphabet), len(expectedLetters); actual != expected {
+			t.Fatalf("Expected %d letters in the alphabet but found %d", expected, actual)
+		}
+		for i, expected := range expectedLetters {
+			if actual := alphabet[i]; actual != expected {
+				t.Errorf("Expected letter '%s' in position %d but found '%s'", expected, i, actual)
+			}
+		}
+	})
+}
+
+func TestAlphabet(t *testing.T) {
+
+	alphabet := Alphabet{"CH", "LL", "RR", "A", "C", "H", "L", "O"}
+
+	expectLetters := func(t *testing.T, letters []string, expected ...string) {
+		t.Helper()
+
+		if actual, expectedLen := len(letters), len(expected); actual != expectedLen {
+			t.Fatalf("Expected %d letters but found %d: %v", expectedLen, actual, letters)
+		}
+		for i, e := range expected {
+			if actual := letters[i]; actual != e {
+				t.Errorf("Expected letter '%s' in position %d but found '%s'", e, i, actual)
+			}
+		}
+	}
+
+	t.Run(".Tokenise()", func(t *testing.T) {
+
+		t.Run("splits words into single character letters", func(t *testing.T) {
+			letters, err := alphabet.Tokenise("COLA")
+
+			if err != nil {
+				t.Fatalf("Expected success but got error %v", err)
+			}
+			expectLetters(t, letters, "C", "O", "L", "A")
+		})
+
+		t.Run("matches the longest letters in the alphabet", func(t *testing.T) {
+			letters, err := alphabet.Tokenise("CHOLLA")
+
+			if err != nil {
+				t.Fatalf("Expected success but got error %v", err)
+			}
+			expectLetters(t, letters, "CH", "O", "LL", "A")
+		})
+
+		t.Run("treats bracketed letters as a single tile", func(t *testing.T) {
+			letters, err := alphabet.Tokenise("[C]HOL[L]A[XY]")
+
+			if err != nil {
+				t.Fatalf("Expected success but got error %v", err)
+			}
+			expectLetters(t, letters, "C", "H", "O", "L", "L", "A", "XY")
+		})
+
+		t.Run("treats characters outside the alphabet as single letters", func(t *testing.T) {
+			letters, err := alphabet.Tokenise("AÑ_")
+
+			if err != nil {
+				t.Fatalf("Expected success but got error %v", err)
+			}
+			expectLetters(t, letters, "A", "Ñ", "_")
+		})
+
+		t.Run("returns an error for malformed brackets", func(t *testing.T) {
+			for _, word := range []string{"[CH", "A[]"} {
+				_, err := alphabet.Tokenise(word)
+
+				if actual, expected := err, (MalformedLettersError{word}); actual != expected {
+					t.Errorf("Expected error %v but got %v", expected, actual)
+				}
+			}
+		})
+	})
+}
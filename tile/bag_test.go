@@ -11,10 +11,10 @@ func ExampleBagWithDistribution() {
 
 	// Creates a Bag with 9 x A tiles, 2 x B tiles, 2 x C tiles, and 4 x D tiles
 	bag := BagWithDistribution(Distribution{
-		{Tile{'A', 1}, 9},
-		{Tile{'B', 3}, 2},
-		{Tile{'C', 3}, 2},
-		{Tile{'D', 2}, 4},
+		{Make('A', 1), 9},
+		{Make('B', 3), 2},
+		{Make('C', 3), 2},
+		{Make('D', 2), 4},
 	})
 
 	// Output: Number of tiles in bag: 17
@@ -37,10 +37,10 @@ func TestBag(t *testing.T) {
 
 		t.Run("creates bag with correct distribution of tiles", func(t *testing.T) {
 			dist := Distribution{
-				{Tile{'A', 1}, 9},
-				{Tile{'B', 3}, 2},
-				{Tile{'C', 3}, 2},
-				{Tile{'D', 2}, 4},
+				{Make('A', 1), 9},
+				{Make('B', 3), 2},
+				{Make('C', 3), 2},
+				{Make('D', 2), 4},
 			}
 			bag := BagWithDistribution(dist)
 
@@ -57,10 +57,10 @@ func TestBag(t *testing.T) {
 
 		t.Run("creates bag with deterministic ordering", func(t *testing.T) {
 			dist := Distribution{
-				{Tile{'A', 1}, 2},
-				{Tile{'B', 3}, 2},
-				{Tile{'C', 3}, 2},
-				{Tile{'D', 2}, 2},
+				{Make('A', 1), 2},
+				{Make('B', 3), 2},
+				{Make('C', 3), 2},
+				{Make('D', 2), 2},
 			}
 			bag := BagWithDistribution(dist)
 
@@ -78,9 +78,9 @@ func TestBag(t *testing.T) {
 
 		t.Run("allocates exact capacity for requested tiles", func(t *testing.T) {
 			dist := Distribution{
-				{Tile{'A', 1}, 3},
-				{Tile{'B', 2}, 3},
-				{Tile{'C', 3}, 3},
+				{Make('A', 1), 3},
+				{Make('B', 2), 3},
+				{Make('C', 3), 3},
 			}
 			bag := BagWithDistribution(dist)
 
@@ -90,37 +90,84 @@ func TestBag(t *testing.T) {
 		})
 	})
 
+	t.Run("BagWithAppEnglishTiles()", func(t *testing.T) {
+
+		t.Run("creates a bag with correct distribution of tiles", func(t *testing.T) {
+			expectedDist := Distribution{
+				{MakeBlank(), 2},
+				{Make('A', 1), 9},
+				{Make('B', 4), 2},
+				{Make('C', 4), 2},
+				{Make('D', 2), 5},
+				{Make('E', 1), 13},
+				{Make('F', 4), 2},
+				{Make('G', 3), 3},
+				{Make('H', 3), 4},
+				{Make('I', 1), 8},
+				{Make('J', 10), 1},
+				{Make('K', 5), 1},
+				{Make('L', 2), 4},
+				{Make('M', 4), 2},
+				{Make('N', 2), 5},
+				{Make('O', 1), 8},
+				{Make('P', 4), 2},
+				{Make('Q', 10), 1},
+				{Make('R', 1), 6},
+				{Make('S', 1), 5},
+				{Make('T', 1), 7},
+				{Make('U', 2), 4},
+				{Make('V', 5), 2},
+				{Make('W', 4), 2},
+				{Make('X', 8), 1},
+				{Make('Y', 3), 2},
+				{Make('Z', 10), 1},
+			}
+
+			bag := BagWithAppEnglishTiles()
+
+			if actual, expected := len(bag), 104; actual != expected {
+				t.Fatalf("Expected bag of %d tiles but got %d", expected, actual)
+			}
+
+			for _, d := range expectedDist {
+				if actual, expected := tileCount(d.Tile, bag), d.Count; actual != expected {
+					t.Errorf("Expected %d of tile %v but found %d", expected, d.Tile, actual)
+				}
+			}
+		})
+	})
+
 	t.Run("BagWithStandardEnglishTiles()", func(t *testing.T) {
 
 		t.Run("creates a bag with correct distribution of tiles", func(t *testing.T) {
 			expectedDist := Distribution{
-				{Tile{' ', 0}, 2},
-				{Tile{'A', 1}, 9},
-				{Tile{'B', 3}, 2},
-				{Tile{'C', 3}, 2},
-				{Tile{'D', 2}, 4},
-				{Tile{'E', 1}, 12},
-				{Tile{'F', 4}, 2},
-				{Tile{'G', 2}, 3},
-				{Tile{'H', 4}, 2},
-				{Tile{'I', 1}, 9},
-				{Tile{'J', 8}, 1},
-				{Tile{'K', 5}, 1},
-				{Tile{'L', 1}, 4},
-				{Tile{'M', 3}, 2},
-				{Tile{'N', 1}, 6},
-				{Tile{'O', 1}, 8},
-				{Tile{'P', 3}, 2},
-				{Tile{'Q', 10}, 1},
-				{Tile{'R', 1}, 6},
-				{Tile{'S', 1}, 4},
-				{Tile{'T', 1}, 6},
-				{Tile{'U', 1}, 4},
-				{Tile{'V', 4}, 2},
-				{Tile{'W', 4}, 2},
-				{Tile{'X', 8}, 1},
-				{Tile{'Y', 4}, 2},
-				{Tile{'Z', 10}, 1},
+				{MakeBlank(), 2},
+				{Make('A', 1), 9},
+				{Make('B', 3), 2},
+				{Make('C', 3), 2},
+				{Make('D', 2), 4},
+				{Make('E', 1), 12},
+				{Make('F', 4), 2},
+				{Make('G', 2), 3},
+				{Make('H', 4), 2},
+				{Make('I', 1), 9},
+				{Make('J', 8), 1},
+				{Make('K', 5), 1},
+				{Make('L', 1), 4},
+				{Make('M', 3), 2},
+				{Make('N', 1), 6},
+				{Make('O', 1), 8},
+				{Make('P', 3), 2},
+				{Make('Q', 10), 1},
+				{Make('R', 1), 6},
+				{Make('S', 1), 4},
+				{Make('T', 1), 6},
+				{Make('U', 1), 4},
+				{Make('V', 4), 2},
+				{Make('W', 4), 2},
+				{Make('X', 8), 1},
+				{Make('Y', 4), 2},
+				{Make('Z', 10), 1},
 			}
 
 			bag := BagWithStandardEnglishTiles()
@@ -137,13 +184,60 @@ func TestBag(t *testing.T) {
 		})
 	})
 
+	t.Run("BagWithSuperEnglishTiles()", func(t *testing.T) {
+
+		t.Run("creates a bag with correct distribution of tiles", func(t *testing.T) {
+			expectedDist := Distribution{
+				{MakeBlank(), 4},
+				{Make('A', 1), 16},
+				{Make('B', 3), 4},
+				{Make('C', 3), 6},
+				{Make('D', 2), 8},
+				{Make('E', 1), 24},
+				{Make('F', 4), 4},
+				{Make('G', 2), 5},
+				{Make('H', 4), 5},
+				{Make('I', 1), 13},
+				{Make('J', 8), 2},
+				{Make('K', 5), 2},
+				{Make('L', 1), 7},
+				{Make('M', 3), 6},
+				{Make('N', 1), 13},
+				{Make('O', 1), 15},
+				{Make('P', 3), 4},
+				{Make('Q', 10), 2},
+				{Make('R', 1), 13},
+				{Make('S', 1), 10},
+				{Make('T', 1), 15},
+				{Make('U', 1), 7},
+				{Make('V', 4), 3},
+				{Make('W', 4), 4},
+				{Make('X', 8), 2},
+				{Make('Y', 4), 4},
+				{Make('Z', 10), 2},
+			}
+
+			bag := BagWithSuperEnglishTiles()
+
+			if actual, expected := len(bag), 200; actual != expected {
+				t.Fatalf("Expected bag of %d tiles but got %d", expected, actual)
+			}
+
+			for _, d := range expectedDist {
+				if actual, expected := tileCount(d.Tile, bag), d.Count; actual != expected {
+					t.Errorf("Expected %d of tile %v but found %d", expected, d.Tile, actual)
+				}
+			}
+		})
+	})
+
 	t.Run(".DrawTile()", func(t *testing.T) {
 
 		t.Run("removes and returns tiles in last to first order", func(t *testing.T) {
 			dist := Distribution{
-				{Tile{'A', 1}, 1},
-				{Tile{'B', 2}, 1},
-				{Tile{'C', 3}, 1},
+				{Make('A', 1), 1},
+				{Make('B', 2), 1},
+				{Make('C', 3), 1},
 			}
 			bag := BagWithDistribution(dist)
 
@@ -185,10 +279,10 @@ func TestBag(t *testing.T) {
 		t.Run("randomises the order of the tiles using the specified random generator", func(t *testing.T) {
 
 			tiles := []Tile{
-				{'A', 1},
-				{'B', 2},
-				{'C', 3},
-				{'D', 4},
+				Make('A', 1),
+				Make('B', 2),
+				Make('C', 3),
+				Make('D', 4),
 			}
 
 			bag := make(Bag, len(tiles))
@@ -3,3 +3,13 @@ package tile
 // Distribution is a collection of tile frequencies. This is used to create
 // a Bag containing a particular distribution of tiles.
 type Distribution []Frequency
+
+// Alphabet returns the Alphabet of letters on the tiles of this distribution,
+// excluding any blank tiles.
+func (d Distribution) Alphabet() Alphabet {
+	tiles := make([]Tile, len(d))
+	for i, f := range d {
+		tiles[i] = f.Tile
+	}
+	return AlphabetOf(tiles...)
+}
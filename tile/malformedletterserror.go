@@ -0,0 +1,13 @@
+package tile
+
+import "fmt"
+
+// MalformedLettersError indicates that a string of letters could not be split
+// into the letters of individual tiles.
+type MalformedLettersError struct {
+	Letters string
+}
+
+func (e MalformedLettersError) Error() string {
+	return fmt.Sprintf("%#v", e)
+}
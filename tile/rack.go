@@ -11,7 +11,14 @@ type Rack []Tile
 // specified bag. If the bag holds less than the required number of tiles, all
 // are added to the rack.
 func (r *Rack) FillFromBag(b *Bag) (drawn []Tile) {
-	for needed := MaxRackTiles - len(*r); needed > 0 && len(*b) > 0; needed-- {
+	return r.FillFromBagTo(b, MaxRackTiles)
+}
+
+// FillFromBagTo fills the rack up to the specified number of tiles by drawing
+// from the specified bag, for games which use a different rack size. If the
+// bag holds less than the required number of tiles, all are added to the rack.
+func (r *Rack) FillFromBagTo(b *Bag, size int) (drawn []Tile) {
+	for needed := size - len(*r); needed > 0 && len(*b) > 0; needed-- {
 		drawn = append(drawn, b.DrawTile())
 	}
 	*r = append(*r, drawn...)
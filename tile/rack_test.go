@@ -10,9 +10,9 @@ func TestRack(t *testing.T) {
 			var r Rack
 
 			b := BagWithDistribution(Distribution{
-				{Tile{'A', 1}, 3},
-				{Tile{'B', 1}, 3},
-				{Tile{'C', 1}, 3},
+				{Make('A', 1), 3},
+				{Make('B', 1), 3},
+				{Make('C', 1), 3},
 			})
 
 			drawn := r.FillFromBag(&b)
@@ -25,38 +25,38 @@ func TestRack(t *testing.T) {
 			}
 
 			expectTiles(t, "racked", r,
-				Tile{'C', 1},
-				Tile{'C', 1},
-				Tile{'C', 1},
-				Tile{'B', 1},
-				Tile{'B', 1},
-				Tile{'B', 1},
-				Tile{'A', 1})
+				Make('C', 1),
+				Make('C', 1),
+				Make('C', 1),
+				Make('B', 1),
+				Make('B', 1),
+				Make('B', 1),
+				Make('A', 1))
 
 			expectTiles(t, "drawn", drawn,
-				Tile{'C', 1},
-				Tile{'C', 1},
-				Tile{'C', 1},
-				Tile{'B', 1},
-				Tile{'B', 1},
-				Tile{'B', 1},
-				Tile{'A', 1})
+				Make('C', 1),
+				Make('C', 1),
+				Make('C', 1),
+				Make('B', 1),
+				Make('B', 1),
+				Make('B', 1),
+				Make('A', 1))
 		})
 
 		t.Run("moves enough from the bag to a partially filled rack to reach MaxRackTiles", func(t *testing.T) {
 			r := Rack{
-				{'F', 1},
-				{'G', 1},
-				{'H', 1},
-				{'I', 1},
+				Make('F', 1),
+				Make('G', 1),
+				Make('H', 1),
+				Make('I', 1),
 			}
 
 			b := BagWithDistribution(Distribution{
-				{Tile{'A', 1}, 1},
-				{Tile{'B', 1}, 1},
-				{Tile{'C', 1}, 1},
-				{Tile{'D', 1}, 1},
-				{Tile{'E', 1}, 1},
+				{Make('A', 1), 1},
+				{Make('B', 1), 1},
+				{Make('C', 1), 1},
+				{Make('D', 1), 1},
+				{Make('E', 1), 1},
 			})
 
 			drawn := r.FillFromBag(&b)
@@ -69,27 +69,27 @@ func TestRack(t *testing.T) {
 			}
 
 			expectTiles(t, "racked", r,
-				Tile{'F', 1},
-				Tile{'G', 1},
-				Tile{'H', 1},
-				Tile{'I', 1},
-				Tile{'E', 1},
-				Tile{'D', 1},
-				Tile{'C', 1})
+				Make('F', 1),
+				Make('G', 1),
+				Make('H', 1),
+				Make('I', 1),
+				Make('E', 1),
+				Make('D', 1),
+				Make('C', 1))
 
 			expectTiles(t, "drawn", drawn,
-				Tile{'E', 1},
-				Tile{'D', 1},
-				Tile{'C', 1})
+				Make('E', 1),
+				Make('D', 1),
+				Make('C', 1))
 		})
 
 		t.Run("moves all tiles from the bag when not enough to reach MaxRackTiles", func(t *testing.T) {
 			var r Rack
 
 			b := BagWithDistribution(Distribution{
-				{Tile{'A', 1}, 1},
-				{Tile{'B', 1}, 1},
-				{Tile{'C', 1}, 1},
+				{Make('A', 1), 1},
+				{Make('B', 1), 1},
+				{Make('C', 1), 1},
 			})
 
 			drawn := r.FillFromBag(&b)
@@ -102,14 +102,55 @@ func TestRack(t *testing.T) {
 			}
 
 			expectTiles(t, "racked", r,
-				Tile{'C', 1},
-				Tile{'B', 1},
-				Tile{'A', 1})
+				Make('C', 1),
+				Make('B', 1),
+				Make('A', 1))
 
 			expectTiles(t, "drawn", drawn,
-				Tile{'C', 1},
-				Tile{'B', 1},
-				Tile{'A', 1})
+				Make('C', 1),
+				Make('B', 1),
+				Make('A', 1))
+		})
+	})
+
+	t.Run(".FillFromBagTo()", func(t *testing.T) {
+
+		t.Run("moves tiles from the bag until the rack holds the specified number", func(t *testing.T) {
+			r := Rack{Make('F', 1)}
+
+			b := BagWithDistribution(Distribution{
+				{Make('A', 1), 5},
+				{Make('B', 1), 5},
+			})
+
+			drawn := r.FillFromBagTo(&b, 8)
+
+			if actual, expected := len(r), 8; actual != expected {
+				t.Errorf("Expected filled rack to contain %d tiles but found %d", expected, actual)
+			}
+			if actual, expected := len(drawn), 7; actual != expected {
+				t.Errorf("Expected %d tiles to be drawn but found %d", expected, actual)
+			}
+			if actual, expected := len(b), 3; actual != expected {
+				t.Errorf("Expected bag to contain %d tiles but found %d", expected, actual)
+			}
+		})
+
+		t.Run("moves all tiles from a bag holding fewer than required", func(t *testing.T) {
+			var r Rack
+
+			b := BagWithDistribution(Distribution{
+				{Make('A', 1), 2},
+			})
+
+			r.FillFromBagTo(&b, 8)
+
+			if actual, expected := len(r), 2; actual != expected {
+				t.Errorf("Expected filled rack to contain %d tiles but found %d", expected, actual)
+			}
+			if actual, expected := len(b), 0; actual != expected {
+				t.Errorf("Expected bag to be empty but found %d tiles", actual)
+			}
 		})
 	})
 
@@ -117,32 +158,32 @@ func TestRack(t *testing.T) {
 
 		t.Run("removes the specified tiles", func(t *testing.T) {
 			r := Rack{
-				{'F', 1},
-				{'G', 1},
-				{'H', 1},
-				{'I', 1},
+				Make('F', 1),
+				Make('G', 1),
+				Make('H', 1),
+				Make('I', 1),
 			}
 
-			r.Remove(Tile{'F', 1}, Tile{'H', 1})
+			r.Remove(Make('F', 1), Make('H', 1))
 
 			expectTiles(t, "racked", r,
-				Tile{'G', 1},
-				Tile{'I', 1},
+				Make('G', 1),
+				Make('I', 1),
 			)
 		})
 
 		t.Run("ignores nonexistent tiles", func(t *testing.T) {
 			r := Rack{
-				{'F', 1},
-				{'G', 1},
-				{'H', 1},
+				Make('F', 1),
+				Make('G', 1),
+				Make('H', 1),
 			}
 
-			r.Remove(Tile{'G', 1}, Tile{'X', 1})
+			r.Remove(Make('G', 1), Make('X', 1))
 
 			expectTiles(t, "racked", r,
-				Tile{'F', 1},
-				Tile{'H', 1},
+				Make('F', 1),
+				Make('H', 1),
 			)
 		})
 	})
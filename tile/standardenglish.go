@@ -0,0 +1,104 @@
+package tile
+
+// AppEnglishDistribution returns the English tile and letter distribution of
+// 104 tiles used by the app variant, which has different point values to the
+// standard distribution.
+func AppEnglishDistribution() Distribution {
+	return Distribution{
+		{MakeBlank(), 2},
+		{Make('E', 1), 13},
+		{Make('A', 1), 9},
+		{Make('I', 1), 8},
+		{Make('O', 1), 8},
+		{Make('T', 1), 7},
+		{Make('R', 1), 6},
+		{Make('S', 1), 5},
+		{Make('D', 2), 5},
+		{Make('N', 2), 5},
+		{Make('L', 2), 4},
+		{Make('U', 2), 4},
+		{Make('H', 3), 4},
+		{Make('G', 3), 3},
+		{Make('Y', 3), 2},
+		{Make('B', 4), 2},
+		{Make('C', 4), 2},
+		{Make('F', 4), 2},
+		{Make('M', 4), 2},
+		{Make('P', 4), 2},
+		{Make('W', 4), 2},
+		{Make('K', 5), 1},
+		{Make('V', 5), 2},
+		{Make('X', 8), 1},
+		{Make('J', 10), 1},
+		{Make('Q', 10), 1},
+		{Make('Z', 10), 1},
+	}
+}
+
+// StandardEnglishDistribution returns the standard English tile and letter
+// distribution of 100 tiles.
+func StandardEnglishDistribution() Distribution {
+	return Distribution{
+		{MakeBlank(), 2},
+		{Make('E', 1), 12},
+		{Make('A', 1), 9},
+		{Make('I', 1), 9},
+		{Make('O', 1), 8},
+		{Make('N', 1), 6},
+		{Make('R', 1), 6},
+		{Make('T', 1), 6},
+		{Make('L', 1), 4},
+		{Make('S', 1), 4},
+		{Make('U', 1), 4},
+		{Make('D', 2), 4},
+		{Make('G', 2), 3},
+		{Make('B', 3), 2},
+		{Make('C', 3), 2},
+		{Make('M', 3), 2},
+		{Make('P', 3), 2},
+		{Make('F', 4), 2},
+		{Make('H', 4), 2},
+		{Make('V', 4), 2},
+		{Make('W', 4), 2},
+		{Make('Y', 4), 2},
+		{Make('K', 5), 1},
+		{Make('J', 8), 1},
+		{Make('X', 8), 1},
+		{Make('Q', 10), 1},
+		{Make('Z', 10), 1},
+	}
+}
+
+// SuperEnglishDistribution returns the English tile and letter distribution of
+// 200 tiles used by the super variant.
+func SuperEnglishDistribution() Distribution {
+	return Distribution{
+		{MakeBlank(), 4},
+		{Make('E', 1), 24},
+		{Make('A', 1), 16},
+		{Make('O', 1), 15},
+		{Make('T', 1), 15},
+		{Make('I', 1), 13},
+		{Make('N', 1), 13},
+		{Make('R', 1), 13},
+		{Make('S', 1), 10},
+		{Make('L', 1), 7},
+		{Make('U', 1), 7},
+		{Make('D', 2), 8},
+		{Make('G', 2), 5},
+		{Make('C', 3), 6},
+		{Make('M', 3), 6},
+		{Make('B', 3), 4},
+		{Make('P', 3), 4},
+		{Make('H', 4), 5},
+		{Make('F', 4), 4},
+		{Make('W', 4), 4},
+		{Make('Y', 4), 4},
+		{Make('V', 4), 3},
+		{Make('K', 5), 2},
+		{Make('J', 8), 2},
+		{Make('X', 8), 2},
+		{Make('Q', 10), 2},
+		{Make('Z', 10), 2},
+	}
+}
@@ -3,16 +3,44 @@ package tile
 import "fmt"
 
 // Tile represents a game tile which can be placed on a Board. Each tile has a
-// letter and an associated number of points.
+// letter and an associated number of points. A tile's letter is usually a
+// single character, but may be made up of multiple characters (such as the
+// Spanish CH, LL, and RR tiles).
+//
+// Blank tiles are marked as such, and can be designated any letter at the time
+// of play while remaining identifiable as blanks.
 type Tile struct {
-	Letter rune
+	Letter string
 	Points int
+	Blank  bool
 }
 
+// Make returns a tile with the specified single character letter and points.
 func Make(letter rune, points int) Tile {
-	return Tile{letter, points}
+	return Tile{Letter: string(letter), Points: points}
+}
+
+// MakeBlank returns a blank tile which has not yet been designated a letter.
+func MakeBlank() Tile {
+	return Tile{Letter: " ", Blank: true}
+}
+
+// MakeLetters returns a tile with the specified letter and points, where the
+// letter may be made up of multiple characters.
+func MakeLetters(letters string, points int) Tile {
+	return Tile{Letter: letters, Points: points}
+}
+
+// Designate returns a copy of this tile with the specified letter. This is
+// used to choose the letter that a blank tile represents when it is played.
+func (t Tile) Designate(letter string) Tile {
+	t.Letter = letter
+	return t
 }
 
 func (t Tile) String() string {
-	return fmt.Sprintf("%c(%d)", t.Letter, t.Points)
+	if t.Blank {
+		return fmt.Sprintf("[%s](%d)", t.Letter, t.Points)
+	}
+	return fmt.Sprintf("%s(%d)", t.Letter, t.Points)
 }
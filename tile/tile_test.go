@@ -16,3 +16,57 @@ func expectTiles(t *testing.T, descriptor string, tiles []Tile, expected ...Tile
 		}
 	}
 }
+
+func TestTile(t *testing.T) {
+
+	t.Run("Make()", func(t *testing.T) {
+
+		t.Run("creates a tile with a single character letter", func(t *testing.T) {
+			if actual, expected := Make('Ñ', 8), (Tile{Letter: "Ñ", Points: 8}); actual != expected {
+				t.Errorf("Expected tile %v but got %v", expected, actual)
+			}
+		})
+	})
+
+	t.Run("MakeBlank()", func(t *testing.T) {
+
+		t.Run("creates an undesignated zero-point blank tile", func(t *testing.T) {
+			if actual, expected := MakeBlank(), (Tile{Letter: " ", Points: 0, Blank: true}); actual != expected {
+				t.Errorf("Expected tile %v but got %v", expected, actual)
+			}
+		})
+	})
+
+	t.Run("MakeLetters()", func(t *testing.T) {
+
+		t.Run("creates a tile with a multiple character letter", func(t *testing.T) {
+			if actual, expected := MakeLetters("CH", 5), (Tile{Letter: "CH", Points: 5}); actual != expected {
+				t.Errorf("Expected tile %v but got %v", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".Designate()", func(t *testing.T) {
+
+		t.Run("returns a blank with the specified letter", func(t *testing.T) {
+			if actual, expected := MakeBlank().Designate("LL"), (Tile{Letter: "LL", Points: 0, Blank: true}); actual != expected {
+				t.Errorf("Expected tile %v but got %v", expected, actual)
+			}
+		})
+	})
+
+	t.Run(".String()", func(t *testing.T) {
+
+		t.Run("returns the letter and points", func(t *testing.T) {
+			if actual, expected := MakeLetters("LL", 8).String(), "LL(8)"; actual != expected {
+				t.Errorf("Expected '%s' but got '%s'", expected, actual)
+			}
+		})
+
+		t.Run("marks designated blanks", func(t *testing.T) {
+			if actual, expected := MakeBlank().Designate("Q").String(), "[Q](0)"; actual != expected {
+				t.Errorf("Expected '%s' but got '%s'", expected, actual)
+			}
+		})
+	})
+}
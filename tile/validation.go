@@ -10,10 +10,11 @@ package tile
 // removed from the rack) is returned with no error, indicating that it would be
 // safe to update the rack for placement.
 //
-// Zero-point tiles are treated as wildcards: a zero-point tile being placed
-// matches any zero-point tile in the rack regardless of letter. This implies
-// that wildcards need to have their letters replaced with the desired letter
-// when being placed (so that any resulting words are valid).
+// Blank tiles are treated as wildcards: a blank tile being placed matches any
+// blank tile in the rack regardless of letter. This implies that blanks need to
+// be designated the desired letter when being placed (so that any resulting
+// words are valid). Other tiles, including those worth zero points, must match
+// exactly.
 func ValidateFromRack(rack Rack, toPlay []Tile) (used, remaining []Tile, err error) {
 	var missing []Tile
 	used = make([]Tile, 0, len(toPlay))
@@ -22,7 +23,7 @@ func ValidateFromRack(rack Rack, toPlay []Tile) (used, remaining []Tile, err err
 Placements:
 	for _, p := range toPlay {
 		for i, t := range remaining {
-			if (t.Points == 0 && p.Points == 0) || t == p {
+			if (t.Blank && p.Blank && t.Points == p.Points) || t == p {
 				used = append(used, t)
 				remaining = append(remaining[:i], remaining[i+1:]...)
 				continue Placements
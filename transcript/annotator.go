@@ -0,0 +1,5 @@
+package transcript
+
+// Annotator represents a function which returns a note to be shown alongside a
+// word formed by a play, or an empty string if there is nothing to note.
+type Annotator func(word string) (note string)
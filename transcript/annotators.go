@@ -0,0 +1,16 @@
+package transcript
+
+import (
+	"strings"
+
+	"github.com/mandykoh/scrubble/dict"
+)
+
+// Anagrams returns an Annotator which notes the valid words which each played
+// word is an anagram of, according to the specified index. This shows which
+// real words the strings played in a game of Clabbers stand for.
+func Anagrams(index *dict.AlphagramIndex) Annotator {
+	return func(word string) string {
+		return strings.Join(index.Anagrams(word), "/")
+	}
+}
@@ -0,0 +1,67 @@
+package transcript
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/mandykoh/scrubble/history"
+)
+
+// Write writes a transcript of the specified game history, with one line per
+// entry showing the player, what they did, and the points they scored. Players
+// are named by seat index, with "Seat N" used for seats without a name.
+//
+// If an Annotator is given, each word formed by a play is followed by its
+// note in brackets.
+func Write(w io.Writer, h history.History, players []string, annotate Annotator) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	for i, e := range h {
+		player := fmt.Sprintf("Seat %d", e.SeatIndex+1)
+		if e.SeatIndex < len(players) {
+			player = players[e.SeatIndex]
+		}
+
+		score := ""
+		if e.Type == history.PlayEntryType {
+			score = fmt.Sprintf("%d", e.Score)
+		}
+
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", i+1, player, describe(&e, annotate), score)
+	}
+
+	return tw.Flush()
+}
+
+func describe(e *history.Entry, annotate Annotator) string {
+	switch e.Type {
+	case history.PlayEntryType:
+		words := make([]string, len(e.WordsFormed))
+		for i, word := range e.WordsFormed {
+			words[i] = word.Word
+			if annotate != nil {
+				if note := annotate(word.Word); note != "" {
+					words[i] = fmt.Sprintf("%s [%s]", word.Word, note)
+				}
+			}
+		}
+		return strings.Join(words, ", ")
+
+	case history.PassEntryType:
+		return "passed"
+
+	case history.ExchangeTilesEntryType:
+		return fmt.Sprintf("exchanged %d tiles", len(e.TilesSpent))
+
+	case history.ChallengeFailEntryType:
+		return "challenged unsuccessfully"
+
+	case history.ChallengeSuccessEntryType:
+		return "challenged successfully, play withdrawn"
+
+	default:
+		return e.Type.String()
+	}
+}
@@ -0,0 +1,57 @@
+package transcript
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mandykoh/scrubble/coord"
+	"github.com/mandykoh/scrubble/dict"
+	"github.com/mandykoh/scrubble/history"
+	"github.com/mandykoh/scrubble/play"
+	"github.com/mandykoh/scrubble/tile"
+)
+
+func TestWrite(t *testing.T) {
+	var h history.History
+	h.AppendPlay(0, 14, nil, play.Tiles{
+		{tile.Make('B', 3), coord.Make(7, 7)},
+		{tile.Make('C', 3), coord.Make(7, 8)},
+		{tile.Make('A', 1), coord.Make(7, 9)},
+	}, nil, []play.Word{{Word: "BCA", Score: 14}})
+	h.AppendChallengeFail(1)
+	h.AppendExchange(1, []tile.Tile{tile.Make('Q', 10), tile.Make('V', 4)}, nil)
+	h.AppendPlay(0, 6, nil, nil, nil, []play.Word{{Word: "XYZ", Score: 6}, {Word: "TAC", Score: 5}})
+	h.AppendChallengeSuccess(1)
+	h.AppendPass(2)
+
+	t.Run("writes a line for each history entry", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		if err := Write(&buf, h, []string{"alice", "bob"}, nil); err != nil {
+			t.Fatalf("Expected no error but got %v", err)
+		}
+
+		expected := "" +
+			"1  alice   BCA                                      14\n" +
+			"2  bob     challenged unsuccessfully                \n" +
+			"3  bob     exchanged 2 tiles                        \n" +
+			"4  alice   XYZ, TAC                                 6\n" +
+			"5  bob     challenged successfully, play withdrawn  \n" +
+			"6  Seat 3  passed                                   \n"
+
+		if actual := buf.String(); actual != expected {
+			t.Errorf("Expected transcript:\n%s\nbut got:\n%s", expected, actual)
+		}
+	})
+
+	t.Run("annotates played words", func(t *testing.T) {
+		words := dict.NewWordList([]string{"cab", "cat", "act"}, dict.FoldCase("en"))
+		var buf bytes.Buffer
+
+		Write(&buf, h[3:4], []string{"alice"}, Anagrams(dict.NewAlphagramIndex(words)))
+
+		if actual, expected := buf.String(), "1  alice  XYZ, TAC [act/cat]  6\n"; actual != expected {
+			t.Errorf("Expected transcript %q but got %q", expected, actual)
+		}
+	})
+}